@@ -0,0 +1,300 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// projectRoleRank orders project roles from least to most privileged.
+var projectRoleRank = map[ProjectRole]int{
+	ProjectRoleViewer:    1,
+	ProjectRoleDeveloper: 2,
+	ProjectRoleAdmin:     3,
+}
+
+// ProjectAccessGrant is one way a user has access to a project.
+type ProjectAccessGrant struct {
+	Role ProjectRole
+	// AccessGroup is the group granting the role, or nil for project
+	// membership.
+	AccessGroup *AccessGroup
+	// FromTeam is set when the membership comes from the user's team role,
+	// such as a team owner, rather than a direct project role.
+	FromTeam bool
+}
+
+// ProjectAccess describes a user who can access a project and why.
+type ProjectAccess struct {
+	UID      string
+	Username string
+	Email    string
+	// Role is the most privileged role across all grants.
+	Role   ProjectRole
+	Grants []ProjectAccessGrant
+}
+
+// ListAccessGroups lists one page of the access groups of the team.
+func (c *Client) ListAccessGroups(ctx context.Context, opts ListAccessGroupsOptions) (*ListAccessGroupsResponse, error) {
+	query := map[string]string{
+		"projectId": opts.ProjectID,
+		"search":    opts.Search,
+		"next":      opts.Next,
+	}
+	if opts.Limit > 0 {
+		query["limit"] = strconv.Itoa(opts.Limit)
+	}
+
+	var resp ListAccessGroupsResponse
+	if err := c.doRequest(ctx, "GET", "/v1/access-groups", query, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetAccessGroup retrieves an access group by ID or name.
+func (c *Client) GetAccessGroup(ctx context.Context, idOrName string) (*AccessGroup, error) {
+	var group AccessGroup
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v1/access-groups/%s", idOrName), nil, nil, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// CreateAccessGroup creates an access group, optionally with members and
+// project roles.
+func (c *Client) CreateAccessGroup(ctx context.Context, req CreateAccessGroupRequest) (*AccessGroup, error) {
+	if req.Name == "" {
+		return nil, &ValidationError{Field: "name", Message: "is required"}
+	}
+	if err := validateAccessGroupProjects(req.Projects); err != nil {
+		return nil, err
+	}
+
+	var group AccessGroup
+	if err := c.doRequest(ctx, "POST", "/v1/access-groups", nil, req, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// UpdateAccessGroup renames an access group, adds or removes members, or
+// sets project roles.
+func (c *Client) UpdateAccessGroup(ctx context.Context, idOrName string, req UpdateAccessGroupRequest) (*AccessGroup, error) {
+	if err := validateAccessGroupProjects(req.Projects); err != nil {
+		return nil, err
+	}
+
+	var group AccessGroup
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/v1/access-groups/%s", idOrName), nil, req, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// DeleteAccessGroup deletes an access group by ID or name.
+func (c *Client) DeleteAccessGroup(ctx context.Context, idOrName string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v1/access-groups/%s", idOrName), nil, nil, nil)
+}
+
+// ListAccessGroupMembers lists one page of the members of an access group.
+// Pass the previous response's Pagination.Next as next to fetch the next page.
+func (c *Client) ListAccessGroupMembers(ctx context.Context, idOrName string, limit int, next string) (*ListAccessGroupMembersResponse, error) {
+	query := map[string]string{"next": next}
+	if limit > 0 {
+		query["limit"] = strconv.Itoa(limit)
+	}
+
+	var resp ListAccessGroupMembersResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v1/access-groups/%s/members", idOrName), query, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ListAccessGroupProjects lists one page of the projects of an access group
+// with the role the group grants on each. Pass the previous response's
+// Pagination.Next as next to fetch the next page.
+func (c *Client) ListAccessGroupProjects(ctx context.Context, idOrName string, limit int, next string) (*ListAccessGroupProjectsResponse, error) {
+	query := map[string]string{"next": next}
+	if limit > 0 {
+		query["limit"] = strconv.Itoa(limit)
+	}
+
+	var resp ListAccessGroupProjectsResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v1/access-groups/%s/projects", idOrName), query, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetAccessGroupProject retrieves the role an access group grants on a project.
+func (c *Client) GetAccessGroupProject(ctx context.Context, idOrName, projectID string) (*AccessGroupProject, error) {
+	var project AccessGroupProject
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v1/access-groups/%s/projects/%s", idOrName, projectID), nil, nil, &project); err != nil {
+		return nil, err
+	}
+
+	return &project, nil
+}
+
+// AddAccessGroupProject grants an access group a role on a project.
+func (c *Client) AddAccessGroupProject(ctx context.Context, idOrName, projectID string, role ProjectRole) (*AccessGroupProject, error) {
+	if err := validateProjectRole(role); err != nil {
+		return nil, err
+	}
+
+	var project AccessGroupProject
+	body := AccessGroupProjectRole{ProjectID: projectID, Role: role}
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/v1/access-groups/%s/projects", idOrName), nil, body, &project); err != nil {
+		return nil, err
+	}
+
+	return &project, nil
+}
+
+// UpdateAccessGroupProject changes the role an access group grants on a project.
+func (c *Client) UpdateAccessGroupProject(ctx context.Context, idOrName, projectID string, role ProjectRole) (*AccessGroupProject, error) {
+	if err := validateProjectRole(role); err != nil {
+		return nil, err
+	}
+
+	body := struct {
+		Role ProjectRole `json:"role"`
+	}{Role: role}
+
+	var project AccessGroupProject
+	if err := c.doRequest(ctx, "PATCH", fmt.Sprintf("/v1/access-groups/%s/projects/%s", idOrName, projectID), nil, body, &project); err != nil {
+		return nil, err
+	}
+
+	return &project, nil
+}
+
+// RemoveAccessGroupProject revokes the access of an access group to a project.
+func (c *Client) RemoveAccessGroupProject(ctx context.Context, idOrName, projectID string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v1/access-groups/%s/projects/%s", idOrName, projectID), nil, nil, nil)
+}
+
+// ListProjectAccess answers "who can touch this project" by combining the
+// direct members of a project with the members of every access group that
+// grants a role on it. Users are sorted by email, and each carries every
+// grant along with the most privileged role among them.
+func (c *Client) ListProjectAccess(ctx context.Context, projectIDOrName string) ([]ProjectAccess, error) {
+	project, err := c.GetProject(ctx, projectIDOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	byUID := make(map[string]*ProjectAccess)
+	grant := func(uid, username, email string, g ProjectAccessGrant) {
+		access, ok := byUID[uid]
+		if !ok {
+			access = &ProjectAccess{UID: uid, Username: username, Email: email}
+			byUID[uid] = access
+		}
+		access.Grants = append(access.Grants, g)
+		if projectRoleRank[g.Role] > projectRoleRank[access.Role] {
+			access.Role = g.Role
+		}
+	}
+
+	members, err := c.listAllProjectMembers(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		grant(m.UID, m.Username, m.Email, ProjectAccessGrant{Role: effectiveProjectRole(m), FromTeam: projectMemberInherited(m)})
+	}
+
+	groups, err := c.listAllAccessGroups(ctx, ListAccessGroupsOptions{ProjectID: project.ID})
+	if err != nil {
+		return nil, err
+	}
+	for i := range groups {
+		group := &groups[i]
+
+		gp, err := c.GetAccessGroupProject(ctx, group.ID, project.ID)
+		if apiErr, ok := IsAPIError(err); ok && apiErr.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get role of access group %s: %w", group.Name, err)
+		}
+
+		groupMembers, err := c.listAllAccessGroupMembers(ctx, group.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list members of access group %s: %w", group.Name, err)
+		}
+		for _, m := range groupMembers {
+			grant(m.UID, m.Username, m.Email, ProjectAccessGrant{Role: gp.Role, AccessGroup: group})
+		}
+	}
+
+	access := make([]ProjectAccess, 0, len(byUID))
+	for _, a := range byUID {
+		access = append(access, *a)
+	}
+	sort.Slice(access, func(i, j int) bool {
+		return access[i].Email < access[j].Email
+	})
+
+	return access, nil
+}
+
+// listAllAccessGroups pages through ListAccessGroups and returns every group.
+func (c *Client) listAllAccessGroups(ctx context.Context, opts ListAccessGroupsOptions) ([]AccessGroup, error) {
+	var groups []AccessGroup
+	for {
+		resp, err := c.ListAccessGroups(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, resp.AccessGroups...)
+		if resp.Pagination.Next == "" || resp.Pagination.Next == opts.Next {
+			return groups, nil
+		}
+		opts.Next = resp.Pagination.Next
+	}
+}
+
+// listAllAccessGroupMembers pages through ListAccessGroupMembers and returns
+// every member.
+func (c *Client) listAllAccessGroupMembers(ctx context.Context, idOrName string) ([]AccessGroupMember, error) {
+	var members []AccessGroupMember
+	var next string
+	for {
+		resp, err := c.ListAccessGroupMembers(ctx, idOrName, 100, next)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, resp.Members...)
+		if resp.Pagination.Next == "" || resp.Pagination.Next == next {
+			return members, nil
+		}
+		next = resp.Pagination.Next
+	}
+}
+
+func validateAccessGroupProjects(projects []AccessGroupProjectRole) error {
+	for _, p := range projects {
+		if p.ProjectID == "" {
+			return &ValidationError{Field: "projects", Message: "projectId is required"}
+		}
+		if err := validateProjectRole(p.Role); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
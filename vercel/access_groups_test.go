@@ -0,0 +1,134 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAccessGroup_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/access-groups", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body CreateAccessGroupRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "Platform", body.Name)
+		assert.Equal(t, []AccessGroupProjectRole{{ProjectID: "prj-1", Role: ProjectRoleDeveloper}}, body.Projects)
+		assert.Equal(t, []string{"user-1"}, body.MembersToAdd)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"accessGroupId":"ag-1","name":"Platform","membersCount":1,"projectsCount":1}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	group, err := c.CreateAccessGroup(context.Background(), CreateAccessGroupRequest{
+		Name:         "Platform",
+		Projects:     []AccessGroupProjectRole{{ProjectID: "prj-1", Role: ProjectRoleDeveloper}},
+		MembersToAdd: []string{"user-1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ag-1", group.ID)
+}
+
+func TestListAccessGroups_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/access-groups", r.URL.Path)
+		assert.Equal(t, "prj-1", r.URL.Query().Get("projectId"))
+		assert.Equal(t, "cursor-1", r.URL.Query().Get("next"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"accessGroups":[{"accessGroupId":"ag-1","name":"Platform"}],"pagination":{"count":1,"next":null}}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	resp, err := c.ListAccessGroups(context.Background(), ListAccessGroupsOptions{ProjectID: "prj-1", Next: "cursor-1"})
+	require.NoError(t, err)
+	require.Len(t, resp.AccessGroups, 1)
+	assert.Empty(t, resp.Pagination.Next)
+}
+
+func TestUpdateAccessGroupProject_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/access-groups/ag-1/projects/prj-1", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "ADMIN", body["role"])
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"projectId":"prj-1","role":"ADMIN"}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	project, err := c.UpdateAccessGroupProject(context.Background(), "ag-1", "prj-1", ProjectRoleAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, ProjectRoleAdmin, project.Role)
+}
+
+func TestListProjectAccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v9/projects/web":
+			w.Write([]byte(`{"id":"prj-1","name":"web"}`))
+		case "/v1/projects/prj-1/members":
+			w.Write([]byte(`{"members":[
+				{"uid":"user-1","email":"john@example.com","role":"PROJECT_VIEWER"},
+				{"uid":"user-3","email":"owner@example.com","computedProjectRole":"ADMIN","teamRole":"OWNER"}
+			]}`))
+		case "/v1/access-groups":
+			assert.Equal(t, "prj-1", r.URL.Query().Get("projectId"))
+			if r.URL.Query().Get("next") == "" {
+				w.Write([]byte(`{"accessGroups":[{"accessGroupId":"ag-1","name":"Platform"}],"pagination":{"count":1,"next":"cursor-1"}}`))
+				return
+			}
+			w.Write([]byte(`{"accessGroups":[{"accessGroupId":"ag-2","name":"Support"}],"pagination":{"count":1,"next":null}}`))
+		case "/v1/access-groups/ag-1/projects/prj-1":
+			w.Write([]byte(`{"projectId":"prj-1","role":"ADMIN"}`))
+		case "/v1/access-groups/ag-2/projects/prj-1":
+			w.Write([]byte(`{"projectId":"prj-1","role":"PROJECT_VIEWER"}`))
+		case "/v1/access-groups/ag-1/members":
+			w.Write([]byte(`{"members":[{"uid":"user-1","email":"john@example.com"}],"pagination":{"count":1}}`))
+		case "/v1/access-groups/ag-2/members":
+			w.Write([]byte(`{"members":[{"uid":"user-2","email":"jane@example.com"}],"pagination":{"count":1}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	access, err := c.ListProjectAccess(context.Background(), "web")
+	require.NoError(t, err)
+	require.Len(t, access, 3)
+
+	assert.Equal(t, "jane@example.com", access[0].Email)
+	assert.Equal(t, ProjectRoleViewer, access[0].Role)
+	assert.Equal(t, "Support", access[0].Grants[0].AccessGroup.Name)
+
+	assert.Equal(t, "john@example.com", access[1].Email)
+	assert.Equal(t, ProjectRoleAdmin, access[1].Role)
+	require.Len(t, access[1].Grants, 2)
+	assert.Nil(t, access[1].Grants[0].AccessGroup)
+	assert.Equal(t, "Platform", access[1].Grants[1].AccessGroup.Name)
+
+	// Team owners have no direct project role; their access comes from the team.
+	assert.Equal(t, "owner@example.com", access[2].Email)
+	assert.Equal(t, ProjectRoleAdmin, access[2].Role)
+	require.Len(t, access[2].Grants, 1)
+	assert.True(t, access[2].Grants[0].FromTeam)
+	assert.False(t, access[1].Grants[0].FromTeam)
+}
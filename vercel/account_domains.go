@@ -0,0 +1,98 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// ListAccountDomains lists domains registered to the authenticated user or
+// team, across all projects. Pass the previous response's Pagination.Next as
+// until to fetch the next page.
+func (c *Client) ListAccountDomains(ctx context.Context, limit int, until int64) (*ListAccountDomainsResponse, error) {
+	query := make(map[string]string)
+	if limit > 0 {
+		query["limit"] = strconv.Itoa(limit)
+	}
+	if until > 0 {
+		query["until"] = strconv.FormatInt(until, 10)
+	}
+
+	var resp ListAccountDomainsResponse
+	if err := c.doRequest(ctx, "GET", "/v5/domains", query, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetAccountDomain retrieves an account domain by name.
+func (c *Client) GetAccountDomain(ctx context.Context, name string) (*AccountDomain, error) {
+	var resp struct {
+		Domain AccountDomain `json:"domain"`
+	}
+
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v5/domains/%s", name), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Domain, nil
+}
+
+// AddAccountDomain adds an external domain to the account.
+func (c *Client) AddAccountDomain(ctx context.Context, req AddAccountDomainRequest) (*AccountDomain, error) {
+	body := struct {
+		AddAccountDomainRequest
+		Method string `json:"method"`
+	}{req, "add"}
+
+	var resp struct {
+		Domain AccountDomain `json:"domain"`
+	}
+
+	if err := c.doRequest(ctx, "POST", "/v5/domains", nil, body, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Domain, nil
+}
+
+// RemoveAccountDomain removes a domain from the account.
+func (c *Client) RemoveAccountDomain(ctx context.Context, name string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v6/domains/%s", name), nil, nil, nil)
+}
+
+// MoveAccountDomain moves a domain to another team.
+func (c *Client) MoveAccountDomain(ctx context.Context, name, destinationTeamID string) error {
+	body := map[string]string{
+		"op":          "move-out",
+		"destination": destinationTeamID,
+	}
+
+	return c.doRequest(ctx, "PATCH", fmt.Sprintf("/v3/domains/%s", name), nil, body, nil)
+}
+
+// CheckDomainAvailability checks whether a domain is available for purchase.
+func (c *Client) CheckDomainAvailability(ctx context.Context, name string) (*DomainAvailability, error) {
+	var availability DomainAvailability
+	if err := c.doRequest(ctx, "GET", "/v4/domains/status", map[string]string{"name": name}, nil, &availability); err != nil {
+		return nil, err
+	}
+
+	return &availability, nil
+}
+
+// GetDomainPrice retrieves the price of purchasing a domain.
+func (c *Client) GetDomainPrice(ctx context.Context, name string) (*DomainPrice, error) {
+	query := map[string]string{
+		"name": name,
+		"type": "new",
+	}
+
+	var price DomainPrice
+	if err := c.doRequest(ctx, "GET", "/v4/domains/price", query, nil, &price); err != nil {
+		return nil, err
+	}
+
+	return &price, nil
+}
@@ -0,0 +1,146 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAccountDomains_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v5/domains", r.URL.Path)
+		assert.Equal(t, "20", r.URL.Query().Get("limit"))
+		assert.Equal(t, "1609459200000", r.URL.Query().Get("until"))
+
+		resp := ListAccountDomainsResponse{
+			Domains: []AccountDomain{
+				{ID: "dom-1", Name: "example.com", ServiceType: "zeit.world", Verified: true},
+			},
+		}
+		resp.Pagination.Count = 1
+		resp.Pagination.Next = 1609372800000
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	domains, err := c.ListAccountDomains(context.Background(), 20, 1609459200000)
+	require.NoError(t, err)
+	assert.Len(t, domains.Domains, 1)
+	assert.Equal(t, "example.com", domains.Domains[0].Name)
+	assert.Equal(t, int64(1609372800000), domains.Pagination.Next)
+}
+
+func TestGetAccountDomain_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v5/domains/example.com", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"domain": AccountDomain{ID: "dom-1", Name: "example.com", Verified: true},
+		})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	domain, err := c.GetAccountDomain(context.Background(), "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "dom-1", domain.ID)
+	assert.True(t, domain.Verified)
+}
+
+func TestAddAccountDomain_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v5/domains", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "example.com", body["name"])
+		assert.Equal(t, "add", body["method"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"domain": AccountDomain{ID: "dom-1", Name: "example.com", ServiceType: "external"},
+		})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	domain, err := c.AddAccountDomain(context.Background(), AddAccountDomainRequest{Name: "example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "external", domain.ServiceType)
+}
+
+func TestRemoveAccountDomain_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v6/domains/example.com", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	err := c.RemoveAccountDomain(context.Background(), "example.com")
+	require.NoError(t, err)
+}
+
+func TestMoveAccountDomain_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/domains/example.com", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "move-out", body["op"])
+		assert.Equal(t, "team-2", body["destination"])
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	err := c.MoveAccountDomain(context.Background(), "example.com", "team-2")
+	require.NoError(t, err)
+}
+
+func TestDomainAvailabilityAndPrice_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "new-domain.com", r.URL.Query().Get("name"))
+
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/v4/domains/status":
+			json.NewEncoder(w).Encode(DomainAvailability{Available: true})
+		case "/v4/domains/price":
+			assert.Equal(t, "new", r.URL.Query().Get("type"))
+			json.NewEncoder(w).Encode(DomainPrice{Price: 20, Period: 1})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	availability, err := c.CheckDomainAvailability(context.Background(), "new-domain.com")
+	require.NoError(t, err)
+	assert.True(t, availability.Available)
+
+	price, err := c.GetDomainPrice(context.Background(), "new-domain.com")
+	require.NoError(t, err)
+	assert.Equal(t, float64(20), price.Price)
+	assert.Equal(t, 1, price.Period)
+}
@@ -0,0 +1,126 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// aliasRollbackTimeout bounds the rollback of a failed swap, which runs even
+// when the context of the swap is done.
+const aliasRollbackTimeout = 30 * time.Second
+
+// AliasAssignment records the deployment an alias pointed at before a swap.
+type AliasAssignment struct {
+	Alias                string
+	PreviousDeploymentID string // empty when the alias did not exist
+}
+
+// AliasSwapReport reports the outcome of SwapAliases.
+type AliasSwapReport struct {
+	DeploymentID string
+	// Previous lists every requested alias with the deployment it pointed at
+	// before the swap, so the swap can be reverted later.
+	Previous []AliasAssignment
+	// RolledBack is set when a reassignment failed and the earlier ones were
+	// reverted.
+	RolledBack bool
+	// RollbackErrors maps aliases to the error returned when reverting them.
+	RollbackErrors map[string]error
+}
+
+// SwapAliases points every alias at a deployment, for example to promote a
+// new build across several hostnames. The deployment must be READY. If any
+// reassignment fails, the aliases already moved are pointed back at their
+// previous deployments, or removed if they did not exist before, and the
+// report is returned along with the error. The rollback also runs when
+// ctx is canceled or times out partway through the swap.
+func (c *Client) SwapAliases(ctx context.Context, aliases []string, newDeploymentID string) (*AliasSwapReport, error) {
+	deployment, err := c.GetDeployment(ctx, newDeploymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := deployment.ReadyState
+	if state == "" {
+		state = deployment.State
+	}
+	if state != "READY" {
+		return nil, fmt.Errorf("deployment %s is %s, not READY", newDeploymentID, state)
+	}
+
+	report := &AliasSwapReport{DeploymentID: newDeploymentID, RollbackErrors: make(map[string]error)}
+	for _, alias := range aliases {
+		previous, err := c.aliasDeploymentID(ctx, alias)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up alias %s: %w", alias, err)
+		}
+		report.Previous = append(report.Previous, AliasAssignment{Alias: alias, PreviousDeploymentID: previous})
+	}
+
+	var assigned []*AssignAliasResponse
+	for i, alias := range aliases {
+		if report.Previous[i].PreviousDeploymentID == newDeploymentID {
+			assigned = append(assigned, nil)
+			continue
+		}
+
+		resp, err := c.AssignAlias(ctx, newDeploymentID, alias)
+		if err != nil {
+			c.rollbackAliasSwap(ctx, report, assigned)
+			return report, fmt.Errorf("failed to assign alias %s: %w", alias, err)
+		}
+		assigned = append(assigned, resp)
+	}
+
+	return report, nil
+}
+
+// rollbackAliasSwap reverts the reassignments made so far, newest first. It
+// ignores the cancellation of ctx, since a canceled swap still has to be
+// reverted.
+func (c *Client) rollbackAliasSwap(ctx context.Context, report *AliasSwapReport, assigned []*AssignAliasResponse) {
+	report.RolledBack = true
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), aliasRollbackTimeout)
+	defer cancel()
+
+	for i := len(assigned) - 1; i >= 0; i-- {
+		if assigned[i] == nil {
+			continue
+		}
+
+		prev := report.Previous[i]
+		var err error
+		if prev.PreviousDeploymentID != "" {
+			_, err = c.AssignAlias(ctx, prev.PreviousDeploymentID, prev.Alias)
+		} else {
+			err = c.DeleteAlias(ctx, assigned[i].UID)
+		}
+		if err != nil {
+			report.RollbackErrors[prev.Alias] = err
+		}
+	}
+}
+
+// aliasDeploymentID returns the deployment an alias points at, or an empty
+// string if the alias does not exist.
+func (c *Client) aliasDeploymentID(ctx context.Context, alias string) (string, error) {
+	a, err := c.GetAlias(ctx, alias)
+	if apiErr, ok := IsAPIError(err); ok && apiErr.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if a.DeploymentID != "" {
+		return a.DeploymentID, nil
+	}
+	if a.Deployment != nil {
+		return a.Deployment.ID, nil
+	}
+
+	return "", nil
+}
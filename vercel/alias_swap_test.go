@@ -0,0 +1,145 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// aliasSwapServer serves a READY deployment dpl-new, the aliases in current
+// and assignments, failing for the alias in failOn.
+func aliasSwapServer(t *testing.T, current map[string]string, failOn string, calls *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v13/deployments/dpl-new":
+			json.NewEncoder(w).Encode(Deployment{ID: "dpl-new", ReadyState: "READY"})
+		case r.Method == "GET" && len(r.URL.Path) > len("/v4/aliases/"):
+			name := r.URL.Path[len("/v4/aliases/"):]
+			dpl, ok := current[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error":{"code":"not_found","message":"Alias not found"}}`))
+				return
+			}
+			json.NewEncoder(w).Encode(Alias{Alias: name, DeploymentID: dpl})
+		case r.Method == "POST":
+			var body struct {
+				Alias string `json:"alias"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			*calls = append(*calls, r.Method+" "+r.URL.Path+" "+body.Alias)
+			if body.Alias == failOn {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error":{"code":"bad_request","message":"boom"}}`))
+				return
+			}
+			json.NewEncoder(w).Encode(AssignAliasResponse{UID: "uid-" + body.Alias, Alias: body.Alias})
+		case r.Method == "DELETE":
+			*calls = append(*calls, r.Method+" "+r.URL.Path)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestSwapAliases_Success(t *testing.T) {
+	var calls []string
+	server := aliasSwapServer(t, map[string]string{"example.com": "dpl-old"}, "", &calls)
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	report, err := c.SwapAliases(context.Background(), []string{"example.com", "new.example.com"}, "dpl-new")
+	require.NoError(t, err)
+	assert.False(t, report.RolledBack)
+	assert.Equal(t, []AliasAssignment{
+		{Alias: "example.com", PreviousDeploymentID: "dpl-old"},
+		{Alias: "new.example.com"},
+	}, report.Previous)
+	assert.Equal(t, []string{
+		"POST /v2/deployments/dpl-new/aliases example.com",
+		"POST /v2/deployments/dpl-new/aliases new.example.com",
+	}, calls)
+}
+
+func TestSwapAliases_RollsBack(t *testing.T) {
+	var calls []string
+	current := map[string]string{"example.com": "dpl-old", "www.example.com": "dpl-old"}
+	server := aliasSwapServer(t, current, "www.example.com", &calls)
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	report, err := c.SwapAliases(context.Background(), []string{"example.com", "new.example.com", "www.example.com"}, "dpl-new")
+	require.Error(t, err)
+	assert.True(t, report.RolledBack)
+	assert.Empty(t, report.RollbackErrors)
+	assert.Equal(t, []string{
+		"POST /v2/deployments/dpl-new/aliases example.com",
+		"POST /v2/deployments/dpl-new/aliases new.example.com",
+		"POST /v2/deployments/dpl-new/aliases www.example.com",
+		"DELETE /v4/aliases/uid-new.example.com",
+		"POST /v2/deployments/dpl-old/aliases example.com",
+	}, calls)
+}
+
+func TestSwapAliases_NotReady(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Deployment{ID: "dpl-new", ReadyState: "BUILDING"})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	report, err := c.SwapAliases(context.Background(), []string{"example.com"}, "dpl-new")
+	assert.ErrorContains(t, err, "BUILDING")
+	assert.Nil(t, report)
+}
+
+func TestSwapAliases_RollsBackWhenCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v13/deployments/dpl-new":
+			json.NewEncoder(w).Encode(Deployment{ID: "dpl-new", ReadyState: "READY"})
+		case r.Method == "GET":
+			json.NewEncoder(w).Encode(Alias{Alias: r.URL.Path[len("/v4/aliases/"):], DeploymentID: "dpl-old"})
+		case r.Method == "POST":
+			var body struct {
+				Alias string `json:"alias"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			calls = append(calls, r.URL.Path+" "+body.Alias)
+			if body.Alias == "b.example.com" {
+				// The caller gives up while the second alias is being moved.
+				cancel()
+				<-r.Context().Done()
+				return
+			}
+			json.NewEncoder(w).Encode(AssignAliasResponse{UID: "uid-" + body.Alias, Alias: body.Alias})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	report, err := c.SwapAliases(ctx, []string{"a.example.com", "b.example.com"}, "dpl-new")
+	require.ErrorIs(t, err, context.Canceled)
+	assert.True(t, report.RolledBack)
+	assert.Empty(t, report.RollbackErrors)
+	assert.Equal(t, []string{
+		"/v2/deployments/dpl-new/aliases a.example.com",
+		"/v2/deployments/dpl-new/aliases b.example.com",
+		"/v2/deployments/dpl-old/aliases a.example.com",
+	}, calls)
+}
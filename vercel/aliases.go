@@ -40,6 +40,31 @@ func (c *Client) ListDeploymentAliases(ctx context.Context, deploymentID string)
 	return resp.Aliases, nil
 }
 
+// GetAlias retrieves an alias by ID or hostname.
+func (c *Client) GetAlias(ctx context.Context, idOrAlias string) (*Alias, error) {
+	var alias Alias
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v4/aliases/%s", idOrAlias), nil, nil, &alias); err != nil {
+		return nil, err
+	}
+
+	return &alias, nil
+}
+
+// AssignAlias points an alias at a deployment, moving it from the deployment
+// it was previously assigned to, if any.
+func (c *Client) AssignAlias(ctx context.Context, deploymentID, alias string) (*AssignAliasResponse, error) {
+	body := struct {
+		Alias string `json:"alias"`
+	}{Alias: alias}
+
+	var resp AssignAliasResponse
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/v2/deployments/%s/aliases", deploymentID), nil, body, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
 // CreateAlias creates a new alias.
 func (c *Client) CreateAlias(ctx context.Context, req CreateAliasRequest) (*Alias, error) {
 	var alias Alias
@@ -96,3 +96,19 @@ func TestDeleteAlias_Success(t *testing.T) {
 	err := c.DeleteAlias(context.Background(), "alias-1")
 	require.NoError(t, err)
 }
+
+func TestGetAlias_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/aliases/my-app.vercel.app", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Alias{ID: "alias-1", Alias: "my-app.vercel.app", DeploymentID: "dpl-1"})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	alias, err := c.GetAlias(context.Background(), "my-app.vercel.app")
+	require.NoError(t, err)
+	assert.Equal(t, "dpl-1", alias.DeploymentID)
+}
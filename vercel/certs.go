@@ -0,0 +1,186 @@
+package vercel
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ListCerts lists the certificates of the authenticated user or team. Pass
+// the previous response's Pagination.Next as until to fetch the next page.
+func (c *Client) ListCerts(ctx context.Context, limit int, until int64) (*ListCertsResponse, error) {
+	query := make(map[string]string)
+	if limit > 0 {
+		query["limit"] = strconv.Itoa(limit)
+	}
+	if until > 0 {
+		query["until"] = strconv.FormatInt(until, 10)
+	}
+
+	var resp ListCertsResponse
+	if err := c.doRequest(ctx, "GET", "/v7/certs", query, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetCert retrieves a certificate by ID.
+func (c *Client) GetCert(ctx context.Context, id string) (*Cert, error) {
+	var cert Cert
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v7/certs/%s", id), nil, nil, &cert); err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+// IssueCert issues a new certificate for the given common names.
+func (c *Client) IssueCert(ctx context.Context, cns []string) (*Cert, error) {
+	if len(cns) == 0 {
+		return nil, &ValidationError{Field: "cns", Message: "at least one common name is required"}
+	}
+
+	body := struct {
+		CNs []string `json:"cns"`
+	}{CNs: cns}
+
+	var cert Cert
+	if err := c.doRequest(ctx, "POST", "/v7/certs", nil, body, &cert); err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+// UploadCert uploads a certificate. Unless SkipValidation is set, the
+// certificate, key and CA chain are validated locally before upload.
+func (c *Client) UploadCert(ctx context.Context, req UploadCertRequest) (*Cert, error) {
+	if !req.SkipValidation {
+		if err := req.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	var cert Cert
+	if err := c.doRequest(ctx, "PUT", "/v7/certs", nil, req, &cert); err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+// DeleteCert deletes a certificate by ID.
+func (c *Client) DeleteCert(ctx context.Context, id string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v7/certs/%s", id), nil, nil, nil)
+}
+
+// ListExpiringCerts returns the certificates of the authenticated user or
+// team that expire within the given window, including ones that have
+// already expired, sorted by expiry.
+func (c *Client) ListExpiringCerts(ctx context.Context, within time.Duration) ([]Cert, error) {
+	deadline := time.Now().Add(within).UnixMilli()
+
+	var expiring []Cert
+	var until int64
+	for {
+		resp, err := c.ListCerts(ctx, 100, until)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cert := range resp.Certs {
+			if cert.ExpiresAt > 0 && cert.ExpiresAt <= deadline {
+				expiring = append(expiring, cert)
+			}
+		}
+
+		if resp.Pagination.Next == 0 || len(resp.Certs) == 0 {
+			break
+		}
+		until = resp.Pagination.Next
+	}
+
+	sort.Slice(expiring, func(i, j int) bool {
+		return expiring[i].ExpiresAt < expiring[j].ExpiresAt
+	})
+
+	return expiring, nil
+}
+
+// Validate checks that the certificate is currently valid, matches the
+// private key, and chains to the CA certificates.
+func (r UploadCertRequest) Validate() error {
+	leaf, err := parseCertPEM("cert", r.Cert)
+	if err != nil {
+		return err
+	}
+	if _, err := tls.X509KeyPair([]byte(r.Cert), []byte(r.Key)); err != nil {
+		return &ValidationError{Field: "key", Message: err.Error()}
+	}
+
+	now := time.Now()
+	if now.Before(leaf[0].NotBefore) {
+		return &ValidationError{Field: "cert", Message: fmt.Sprintf("not valid before %s", leaf[0].NotBefore.Format(time.RFC3339))}
+	}
+	if now.After(leaf[0].NotAfter) {
+		return &ValidationError{Field: "cert", Message: fmt.Sprintf("expired at %s", leaf[0].NotAfter.Format(time.RFC3339))}
+	}
+
+	chain, err := parseCertPEM("ca", r.CA)
+	if err != nil {
+		return err
+	}
+
+	// The chain may end at an intermediate, so every CA certificate is
+	// accepted as a trust anchor; this checks the chain, not public trust.
+	pool := x509.NewCertPool()
+	for _, ca := range chain {
+		pool.AddCert(ca)
+	}
+	for _, cert := range leaf[1:] {
+		pool.AddCert(cert)
+	}
+	if _, err := leaf[0].Verify(x509.VerifyOptions{
+		Roots:       pool,
+		CurrentTime: now,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return &ValidationError{Field: "ca", Message: err.Error()}
+	}
+
+	return nil
+}
+
+// parseCertPEM parses every CERTIFICATE block of a PEM bundle.
+func parseCertPEM(field, data string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, &ValidationError{Field: field, Message: err.Error()}
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, &ValidationError{Field: field, Message: "no PEM encoded certificate found"}
+	}
+
+	return certs, nil
+}
@@ -0,0 +1,164 @@
+package vercel
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCertBundle returns a PEM encoded leaf certificate, its key and the CA
+// that signed it.
+func testCertBundle(t *testing.T, notAfter time.Time) (cert, key, ca string) {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, caTemplate, &leafKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(leafKey)
+	require.NoError(t, err)
+
+	cert = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER}))
+	key = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	ca = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}))
+
+	return cert, key, ca
+}
+
+func TestIssueCert_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v7/certs", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string][]string
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, []string{"example.com", "www.example.com"}, body["cns"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Cert{ID: "cert-1", CNs: body["cns"], AutoRenew: true})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	cert, err := c.IssueCert(context.Background(), []string{"example.com", "www.example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "cert-1", cert.ID)
+}
+
+func TestUploadCert_Success(t *testing.T) {
+	certPEM, keyPEM, caPEM := testCertBundle(t, time.Now().Add(90*24*time.Hour))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v7/certs", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		var body UploadCertRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, certPEM, body.Cert)
+		assert.Equal(t, caPEM, body.CA)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Cert{ID: "cert-2", CNs: []string{"example.com"}})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	cert, err := c.UploadCert(context.Background(), UploadCertRequest{Cert: certPEM, Key: keyPEM, CA: caPEM})
+	require.NoError(t, err)
+	assert.Equal(t, "cert-2", cert.ID)
+}
+
+func TestUploadCertRequest_Validate(t *testing.T) {
+	certPEM, keyPEM, caPEM := testCertBundle(t, time.Now().Add(90*24*time.Hour))
+	_, otherKey, otherCA := testCertBundle(t, time.Now().Add(90*24*time.Hour))
+	expiredCert, expiredKey, expiredCA := testCertBundle(t, time.Now().Add(-time.Minute))
+
+	tests := map[string]struct {
+		req   UploadCertRequest
+		field string
+	}{
+		"mismatched key": {UploadCertRequest{Cert: certPEM, Key: otherKey, CA: caPEM}, "key"},
+		"wrong chain":    {UploadCertRequest{Cert: certPEM, Key: keyPEM, CA: otherCA}, "ca"},
+		"missing chain":  {UploadCertRequest{Cert: certPEM, Key: keyPEM}, "ca"},
+		"expired":        {UploadCertRequest{Cert: expiredCert, Key: expiredKey, CA: expiredCA}, "cert"},
+		"not PEM":        {UploadCertRequest{Cert: "garbage", Key: keyPEM, CA: caPEM}, "cert"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var validationErr *ValidationError
+			require.ErrorAs(t, tt.req.Validate(), &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestListExpiringCerts(t *testing.T) {
+	now := time.Now()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v7/certs", r.URL.Path)
+
+		resp := ListCertsResponse{}
+		if r.URL.Query().Get("until") == "" {
+			resp.Certs = []Cert{
+				{ID: "cert-1", ExpiresAt: now.Add(20 * 24 * time.Hour).UnixMilli()},
+				{ID: "cert-2", ExpiresAt: now.Add(200 * 24 * time.Hour).UnixMilli()},
+			}
+			resp.Pagination.Next = 123
+		} else {
+			assert.Equal(t, "123", r.URL.Query().Get("until"))
+			resp.Certs = []Cert{
+				{ID: "cert-3", ExpiresAt: now.Add(-24 * time.Hour).UnixMilli()},
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	certs, err := c.ListExpiringCerts(context.Background(), 30*24*time.Hour)
+	require.NoError(t, err)
+	require.Len(t, certs, 2)
+	assert.Equal(t, "cert-3", certs[0].ID)
+	assert.Equal(t, "cert-1", certs[1].ID)
+}
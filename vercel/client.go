@@ -93,11 +93,6 @@ func (c *Client) buildURL(path string, query map[string]string) (string, error)
 
 // doRequest performs an HTTP request and handles the response.
 func (c *Client) doRequest(ctx context.Context, method, path string, query map[string]string, body interface{}, v interface{}) error {
-	reqURL, err := c.buildURL(path, query)
-	if err != nil {
-		return err
-	}
-
 	var reqBody io.Reader
 	if body != nil {
 		jsonData, err := json.Marshal(body)
@@ -107,55 +102,57 @@ func (c *Client) doRequest(ctx context.Context, method, path string, query map[s
 		reqBody = bytes.NewBuffer(jsonData)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+
+	respBody, err := c.doRawRequest(ctx, method, path, query, header, reqBody)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.token)
-	req.Header.Set("Content-Type", "application/json")
+	if v != nil {
+		if err := json.Unmarshal(respBody, v); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
 
-	resp, err := c.httpClient.Do(req)
+	return nil
+}
+
+// doRawRequest performs an HTTP request with an arbitrary body and headers
+// and returns the raw response body.
+func (c *Client) doRawRequest(ctx context.Context, method, path string, query map[string]string, header http.Header, body io.Reader) ([]byte, error) {
+	reqURL, err := c.buildURL(path, query)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		apiErr := &APIError{
-			StatusCode: resp.StatusCode,
-			RawBody:    respBody,
-			Message:    http.StatusText(resp.StatusCode),
+	for k, values := range header {
+		for _, value := range values {
+			req.Header.Add(k, value)
 		}
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
 
-		// Try to unmarshal error response
-		var errorResp struct {
-			Error struct {
-				Code    string `json:"code"`
-				Message string `json:"message"`
-			} `json:"error"`
-		}
-		if err := json.Unmarshal(respBody, &errorResp); err == nil {
-			apiErr.Code = errorResp.Error.Code
-			if errorResp.Error.Message != "" {
-				apiErr.Message = errorResp.Error.Message
-			}
-		}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
 
-		return apiErr
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	if v != nil {
-		if err := json.Unmarshal(respBody, v); err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w", err)
-		}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, newAPIError(resp.StatusCode, respBody)
 	}
 
-	return nil
+	return respBody, nil
 }
-
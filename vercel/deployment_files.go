@@ -0,0 +1,159 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DeploymentFilesDiff lists the paths that differ between two deployments.
+type DeploymentFilesDiff struct {
+	Added   []string // present only in the second deployment
+	Removed []string // present only in the first deployment
+	Changed []string // present in both with different contents
+}
+
+// ListDeploymentFiles retrieves the file tree of a deployment by ID.
+func (c *Client) ListDeploymentFiles(ctx context.Context, id string) ([]DeploymentFileTree, error) {
+	var files []DeploymentFileTree
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v6/deployments/%s/files", id), nil, nil, &files); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// GetDeploymentFileContents retrieves the contents of a deployment file by
+// its UID.
+func (c *Client) GetDeploymentFileContents(ctx context.Context, id, fileID string) ([]byte, error) {
+	return c.doRawRequest(ctx, "GET", fmt.Sprintf("/v7/deployments/%s/files/%s", id, fileID), nil, nil, nil)
+}
+
+// DownloadDeploymentFiles writes every file of a deployment below destDir,
+// preserving the deployment's directory structure.
+func (c *Client) DownloadDeploymentFiles(ctx context.Context, id, destDir string) error {
+	tree, err := c.ListDeploymentFiles(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	files, err := FlattenDeploymentFiles(tree)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		f := files[p]
+		if f.Type != "file" || f.UID == "" {
+			continue
+		}
+
+		data, err := c.GetDeploymentFileContents(ctx, id, f.UID)
+		if err != nil {
+			return fmt.Errorf("failed to download %s: %w", p, err)
+		}
+
+		dest := filepath.Join(destDir, filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(dest, data, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DiffDeploymentFiles compares the file trees of two deployments by path
+// and content hash.
+func (c *Client) DiffDeploymentFiles(ctx context.Context, fromID, toID string) (*DeploymentFilesDiff, error) {
+	from, err := c.ListDeploymentFiles(ctx, fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := c.ListDeploymentFiles(ctx, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffDeploymentFileTrees(from, to)
+}
+
+// DiffDeploymentFileTrees compares two deployment file trees by path and
+// content hash. Directories are not reported, only the files inside them.
+func DiffDeploymentFileTrees(from, to []DeploymentFileTree) (*DeploymentFilesDiff, error) {
+	fromFiles, err := FlattenDeploymentFiles(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toFiles, err := FlattenDeploymentFiles(to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &DeploymentFilesDiff{}
+	for p, f := range toFiles {
+		old, ok := fromFiles[p]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, p)
+		case old.UID != f.UID || old.Symlink != f.Symlink || old.Type != f.Type:
+			diff.Changed = append(diff.Changed, p)
+		}
+	}
+	for p := range fromFiles {
+		if _, ok := toFiles[p]; !ok {
+			diff.Removed = append(diff.Removed, p)
+		}
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Changed)
+
+	return diff, nil
+}
+
+// FlattenDeploymentFiles returns every non-directory entry of a file tree
+// keyed by its slash-separated path.
+func FlattenDeploymentFiles(tree []DeploymentFileTree) (map[string]DeploymentFileTree, error) {
+	files := make(map[string]DeploymentFileTree)
+	if err := flattenDeploymentFiles("", tree, files); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func flattenDeploymentFiles(dir string, tree []DeploymentFileTree, files map[string]DeploymentFileTree) error {
+	for _, entry := range tree {
+		if entry.Name == "" || entry.Name == "." || entry.Name == ".." || strings.ContainsAny(entry.Name, `/\`) {
+			return fmt.Errorf("invalid file name %q in %q", entry.Name, dir)
+		}
+
+		p := path.Join(dir, entry.Name)
+		if entry.Type == "directory" {
+			if err := flattenDeploymentFiles(p, entry.Children, files); err != nil {
+				return err
+			}
+			continue
+		}
+
+		files[p] = entry
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFileTree(indexUID string) []DeploymentFileTree {
+	return []DeploymentFileTree{
+		{
+			Name: "static",
+			Type: "directory",
+			Children: []DeploymentFileTree{
+				{Name: "index.html", Type: "file", UID: indexUID},
+				{Name: "app.js", Type: "file", UID: "sha-app"},
+			},
+		},
+		{Name: "package.json", Type: "file", UID: "sha-pkg"},
+	}
+}
+
+func TestListDeploymentFiles_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v6/deployments/dep-1/files", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(testFileTree("sha-index"))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	files, err := c.ListDeploymentFiles(context.Background(), "dep-1")
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.Equal(t, "static", files[0].Name)
+	assert.Len(t, files[0].Children, 2)
+}
+
+func TestDownloadDeploymentFiles_Success(t *testing.T) {
+	contents := map[string]string{
+		"sha-index": "<html></html>",
+		"sha-app":   "console.log(1)",
+		"sha-pkg":   "{}",
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v6/deployments/dep-1/files" {
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(testFileTree("sha-index"))
+			return
+		}
+
+		uid := filepath.Base(r.URL.Path)
+		assert.Equal(t, "/v7/deployments/dep-1/files/"+uid, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(contents[uid]))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+	dir := t.TempDir()
+
+	err := c.DownloadDeploymentFiles(context.Background(), "dep-1", dir)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "static", "index.html"))
+	require.NoError(t, err)
+	assert.Equal(t, "<html></html>", string(data))
+
+	data, err = os.ReadFile(filepath.Join(dir, "package.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "{}", string(data))
+}
+
+func TestDiffDeploymentFileTrees(t *testing.T) {
+	from := testFileTree("sha-index")
+	to := testFileTree("sha-index-2")
+	to[0].Children = to[0].Children[:1]
+	to = append(to, DeploymentFileTree{Name: "robots.txt", Type: "file", UID: "sha-robots"})
+
+	diff, err := DiffDeploymentFileTrees(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"robots.txt"}, diff.Added)
+	assert.Equal(t, []string{"static/app.js"}, diff.Removed)
+	assert.Equal(t, []string{"static/index.html"}, diff.Changed)
+}
+
+func TestFlattenDeploymentFiles_RejectsTraversal(t *testing.T) {
+	tree := []DeploymentFileTree{{Name: "..", Type: "directory", Children: []DeploymentFileTree{
+		{Name: "passwd", Type: "file", UID: "sha"},
+	}}}
+
+	_, err := FlattenDeploymentFiles(tree)
+	require.Error(t, err)
+}
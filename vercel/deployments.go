@@ -1,8 +1,12 @@
 package vercel
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
+	"net/http"
 	"strconv"
 )
 
@@ -61,3 +65,20 @@ func (c *Client) GetDeploymentLogs(ctx context.Context, id string) (*DeploymentL
 
 	return &resp, nil
 }
+
+// UploadDeploymentFile uploads file contents so they can be referenced by
+// SHA in a CreateDeploymentRequest. It returns the SHA1 digest of data.
+func (c *Client) UploadDeploymentFile(ctx context.Context, data []byte) (string, error) {
+	sum := sha1.Sum(data)
+	digest := hex.EncodeToString(sum[:])
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/octet-stream")
+	header.Set("x-vercel-digest", digest)
+
+	if _, err := c.doRawRequest(ctx, "POST", "/v2/files", nil, header, bytes.NewReader(data)); err != nil {
+		return "", err
+	}
+
+	return digest, nil
+}
@@ -151,3 +151,21 @@ func TestGetDeploymentLogs_Success(t *testing.T) {
 	assert.Equal(t, "Building application...", logs.Logs[0].Message)
 	assert.Equal(t, "stdout", logs.Logs[0].Type)
 }
+
+func TestUploadDeploymentFile_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/files", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d", r.Header.Get("x-vercel-digest"))
+		assert.Equal(t, int64(5), r.ContentLength)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	sha, err := c.UploadDeploymentFile(context.Background(), []byte("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, "aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d", sha)
+}
@@ -0,0 +1,266 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	dnsLabelPattern = regexp.MustCompile(`^[A-Za-z0-9_*]([A-Za-z0-9_-]{0,61}[A-Za-z0-9_])?$`)
+	caaValuePattern = regexp.MustCompile(`^(\d{1,3})\s+([A-Za-z0-9]+)\s+"([^"]*)"$`)
+)
+
+var caaTags = map[string]bool{
+	"issue":     true,
+	"issuewild": true,
+	"iodef":     true,
+}
+
+// ListDNSRecords lists the DNS records of a domain. Pass the previous
+// response's Pagination.Next as until to fetch the next page.
+func (c *Client) ListDNSRecords(ctx context.Context, domain string, limit int, until int64) (*ListDNSRecordsResponse, error) {
+	query := make(map[string]string)
+	if limit > 0 {
+		query["limit"] = strconv.Itoa(limit)
+	}
+	if until > 0 {
+		query["until"] = strconv.FormatInt(until, 10)
+	}
+
+	var resp ListDNSRecordsResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v4/domains/%s/records", domain), query, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// CreateDNSRecord creates a DNS record for a domain. The request is
+// validated before it is sent.
+func (c *Client) CreateDNSRecord(ctx context.Context, domain string, req CreateDNSRecordRequest) (*CreateDNSRecordResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.Name == "@" {
+		req.Name = ""
+	}
+
+	var resp CreateDNSRecordResponse
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/v2/domains/%s/records", domain), nil, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateDNSRecord updates a DNS record by ID. The request is validated
+// before it is sent.
+func (c *Client) UpdateDNSRecord(ctx context.Context, recordID string, req UpdateDNSRecordRequest) (*DNSRecord, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var record DNSRecord
+	if err := c.doRequest(ctx, "PATCH", fmt.Sprintf("/v1/domains/records/%s", recordID), nil, req, &record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// DeleteDNSRecord deletes a DNS record of a domain by ID.
+func (c *Client) DeleteDNSRecord(ctx context.Context, domain, recordID string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v2/domains/%s/records/%s", domain, recordID), nil, nil, nil)
+}
+
+// MarshalJSON always includes mxPriority for MX records, where 0 is a
+// valid priority.
+func (r CreateDNSRecordRequest) MarshalJSON() ([]byte, error) {
+	type request CreateDNSRecordRequest
+	out := struct {
+		request
+		MXPriority *int `json:"mxPriority,omitempty"`
+	}{request: request(r)}
+	if r.Type == DNSRecordMX {
+		out.MXPriority = &r.MXPriority
+	}
+
+	return json.Marshal(out)
+}
+
+// Validate checks the record for mistakes the API would reject, such as a
+// CNAME at the zone apex or a malformed CAA value.
+func (r CreateDNSRecordRequest) Validate() error {
+	if err := validateDNSName(r.Name); err != nil {
+		return err
+	}
+	if err := validateDNSTTL(r.TTL); err != nil {
+		return err
+	}
+
+	apex := r.Name == "" || r.Name == "@"
+	switch r.Type {
+	case DNSRecordCNAME:
+		if apex {
+			return &ValidationError{Field: "name", Message: "CNAME records are not allowed at the zone apex"}
+		}
+	case DNSRecordNS:
+		if apex {
+			return &ValidationError{Field: "name", Message: "NS records at the zone apex are managed by Vercel"}
+		}
+	case DNSRecordMX:
+		if r.MXPriority < 0 || r.MXPriority > 65535 {
+			return &ValidationError{Field: "mxPriority", Message: fmt.Sprintf("%d is out of range 0-65535", r.MXPriority)}
+		}
+	case DNSRecordSRV:
+		return validateSRVRecord(r.Name, r.SRV)
+	}
+
+	return validateDNSValue(r.Type, r.Value)
+}
+
+// Validate checks the fields being changed. Values are only checked when
+// the record type is also given.
+func (r UpdateDNSRecordRequest) Validate() error {
+	if r.Name != nil {
+		if err := validateDNSName(*r.Name); err != nil {
+			return err
+		}
+	}
+	if err := validateDNSTTL(r.TTL); err != nil {
+		return err
+	}
+	if r.MXPriority != nil && (*r.MXPriority < 0 || *r.MXPriority > 65535) {
+		return &ValidationError{Field: "mxPriority", Message: fmt.Sprintf("%d is out of range 0-65535", *r.MXPriority)}
+	}
+
+	if r.Type == "" {
+		return nil
+	}
+	if r.Type == DNSRecordCNAME && r.Name != nil && (*r.Name == "" || *r.Name == "@") {
+		return &ValidationError{Field: "name", Message: "CNAME records are not allowed at the zone apex"}
+	}
+	if r.Type == DNSRecordSRV {
+		if r.SRV == nil {
+			return nil
+		}
+		name := "_"
+		if r.Name != nil {
+			name = *r.Name
+		}
+		return validateSRVRecord(name, r.SRV)
+	}
+	if r.Value == "" {
+		return nil
+	}
+
+	return validateDNSValue(r.Type, r.Value)
+}
+
+func validateDNSName(name string) error {
+	if name == "" || name == "@" {
+		return nil
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if !dnsLabelPattern.MatchString(label) {
+			return &ValidationError{Field: "name", Message: fmt.Sprintf("%q is not a valid record name", name)}
+		}
+	}
+
+	return nil
+}
+
+func validateDNSTTL(ttl int) error {
+	if ttl != 0 && ttl < 60 {
+		return &ValidationError{Field: "ttl", Message: fmt.Sprintf("%d is below the minimum of 60 seconds", ttl)}
+	}
+
+	return nil
+}
+
+func validateDNSValue(recordType DNSRecordType, value string) error {
+	switch recordType {
+	case DNSRecordA:
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil || strings.Contains(value, ":") {
+			return &ValidationError{Field: "value", Message: fmt.Sprintf("%q is not an IPv4 address", value)}
+		}
+	case DNSRecordAAAA:
+		if ip := net.ParseIP(value); ip == nil || !strings.Contains(value, ":") {
+			return &ValidationError{Field: "value", Message: fmt.Sprintf("%q is not an IPv6 address", value)}
+		}
+	case DNSRecordCNAME, DNSRecordMX, DNSRecordNS:
+		if !isDNSHostname(value) {
+			return &ValidationError{Field: "value", Message: fmt.Sprintf("%q is not a valid hostname", value)}
+		}
+	case DNSRecordTXT:
+		if value == "" {
+			return &ValidationError{Field: "value", Message: "TXT records must not be empty"}
+		}
+	case DNSRecordCAA:
+		return validateCAAValue(value)
+	default:
+		return &ValidationError{Field: "type", Message: fmt.Sprintf("unsupported record type %q", recordType)}
+	}
+
+	return nil
+}
+
+// validateCAAValue checks a CAA value of the form `0 issue "letsencrypt.org"`.
+func validateCAAValue(value string) error {
+	m := caaValuePattern.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return &ValidationError{Field: "value", Message: fmt.Sprintf(`%q is not of the form <flags> <tag> "<value>"`, value)}
+	}
+
+	if flags, _ := strconv.Atoi(m[1]); flags > 255 {
+		return &ValidationError{Field: "value", Message: fmt.Sprintf("CAA flags %s is out of range 0-255", m[1])}
+	}
+	if !caaTags[strings.ToLower(m[2])] {
+		return &ValidationError{Field: "value", Message: fmt.Sprintf("unknown CAA tag %q", m[2])}
+	}
+
+	return nil
+}
+
+func validateSRVRecord(name string, srv *SRVRecord) error {
+	if srv == nil {
+		return &ValidationError{Field: "srv", Message: "SRV records require srv"}
+	}
+	if !strings.HasPrefix(name, "_") {
+		return &ValidationError{Field: "name", Message: fmt.Sprintf("SRV name %q must start with _service._proto", name)}
+	}
+	if srv.Port < 1 || srv.Port > 65535 {
+		return &ValidationError{Field: "srv.port", Message: fmt.Sprintf("%d is out of range 1-65535", srv.Port)}
+	}
+	if srv.Priority < 0 || srv.Priority > 65535 || srv.Weight < 0 || srv.Weight > 65535 {
+		return &ValidationError{Field: "srv", Message: "priority and weight must be in range 0-65535"}
+	}
+	if !isDNSHostname(srv.Target) {
+		return &ValidationError{Field: "srv.target", Message: fmt.Sprintf("%q is not a valid hostname", srv.Target)}
+	}
+
+	return nil
+}
+
+// isDNSHostname reports whether s is a hostname, optionally fully qualified
+// with a trailing dot.
+func isDNSHostname(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if s == "" || len(s) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(s, ".") {
+		if !dnsLabelPattern.MatchString(label) {
+			return false
+		}
+	}
+
+	return true
+}
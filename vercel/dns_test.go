@@ -0,0 +1,181 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListDNSRecords_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/domains/example.com/records", r.URL.Path)
+		assert.Equal(t, "50", r.URL.Query().Get("limit"))
+
+		resp := ListDNSRecordsResponse{
+			Records: []DNSRecord{
+				{ID: "rec-1", Name: "www", Type: DNSRecordCNAME, Value: "cname.vercel-dns.com"},
+				{ID: "rec-2", Name: "", Type: DNSRecordMX, Value: "mx.example.com", MXPriority: 10},
+			},
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	records, err := c.ListDNSRecords(context.Background(), "example.com", 50, 0)
+	require.NoError(t, err)
+	assert.Len(t, records.Records, 2)
+	assert.Equal(t, 10, records.Records[1].MXPriority)
+}
+
+func TestCreateDNSRecord_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/domains/example.com/records", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "", body["name"])
+		assert.Equal(t, "MX", body["type"])
+		assert.Equal(t, float64(0), body["mxPriority"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateDNSRecordResponse{UID: "rec-1"})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	req := CreateDNSRecordRequest{Name: "@", Type: DNSRecordMX, Value: "mx.example.com", MXPriority: 0, TTL: 3600}
+	resp, err := c.CreateDNSRecord(context.Background(), "example.com", req)
+	require.NoError(t, err)
+	assert.Equal(t, "rec-1", resp.UID)
+}
+
+func TestCreateDNSRecord_SRV(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&req)
+		assert.Equal(t, map[string]interface{}{
+			"priority": float64(10), "weight": float64(5), "port": float64(5060), "target": "sip.example.com",
+		}, req["srv"])
+		assert.NotContains(t, req, "mxPriority")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateDNSRecordResponse{UID: "rec-2"})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	req := CreateDNSRecordRequest{
+		Name: "_sip._tcp",
+		Type: DNSRecordSRV,
+		SRV:  &SRVRecord{Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.com"},
+	}
+	_, err := c.CreateDNSRecord(context.Background(), "example.com", req)
+	require.NoError(t, err)
+}
+
+func TestCreateDNSRecord_ValidationError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent")
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	_, err := c.CreateDNSRecord(context.Background(), "example.com", CreateDNSRecordRequest{
+		Name:  "",
+		Type:  DNSRecordCNAME,
+		Value: "other.example.com",
+	})
+	var valErr *ValidationError
+	require.ErrorAs(t, err, &valErr)
+	assert.Equal(t, "name", valErr.Field)
+}
+
+func TestCreateDNSRecordRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   CreateDNSRecordRequest
+		field string
+	}{
+		{name: "A", req: CreateDNSRecordRequest{Name: "api", Type: DNSRecordA, Value: "76.76.21.21"}},
+		{name: "AAAA", req: CreateDNSRecordRequest{Name: "api", Type: DNSRecordAAAA, Value: "2001:db8::1"}},
+		{name: "TXT apex", req: CreateDNSRecordRequest{Type: DNSRecordTXT, Value: "v=spf1 -all"}},
+		{name: "CAA", req: CreateDNSRecordRequest{Type: DNSRecordCAA, Value: `0 issue "letsencrypt.org"`}},
+		{name: "wildcard CNAME", req: CreateDNSRecordRequest{Name: "*", Type: DNSRecordCNAME, Value: "cname.vercel-dns.com."}},
+		{name: "A with IPv6", req: CreateDNSRecordRequest{Name: "api", Type: DNSRecordA, Value: "2001:db8::1"}, field: "value"},
+		{name: "AAAA with IPv4", req: CreateDNSRecordRequest{Name: "api", Type: DNSRecordAAAA, Value: "1.2.3.4"}, field: "value"},
+		{name: "CNAME apex", req: CreateDNSRecordRequest{Name: "@", Type: DNSRecordCNAME, Value: "x.example.com"}, field: "name"},
+		{name: "CAA bad tag", req: CreateDNSRecordRequest{Type: DNSRecordCAA, Value: `0 issues "letsencrypt.org"`}, field: "value"},
+		{name: "CAA unquoted", req: CreateDNSRecordRequest{Type: DNSRecordCAA, Value: `0 issue letsencrypt.org`}, field: "value"},
+		{name: "CAA flags", req: CreateDNSRecordRequest{Type: DNSRecordCAA, Value: `256 issue "letsencrypt.org"`}, field: "value"},
+		{name: "MX bad host", req: CreateDNSRecordRequest{Type: DNSRecordMX, Value: "not a host"}, field: "value"},
+		{name: "SRV missing", req: CreateDNSRecordRequest{Name: "_sip._tcp", Type: DNSRecordSRV}, field: "srv"},
+		{name: "SRV bad port", req: CreateDNSRecordRequest{Name: "_sip._tcp", Type: DNSRecordSRV, SRV: &SRVRecord{Target: "sip.example.com"}}, field: "srv.port"},
+		{name: "low TTL", req: CreateDNSRecordRequest{Name: "api", Type: DNSRecordA, Value: "1.2.3.4", TTL: 10}, field: "ttl"},
+		{name: "bad name", req: CreateDNSRecordRequest{Name: "bad name", Type: DNSRecordA, Value: "1.2.3.4"}, field: "name"},
+		{name: "unknown type", req: CreateDNSRecordRequest{Name: "api", Type: "PTR", Value: "x"}, field: "type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var valErr *ValidationError
+			require.ErrorAs(t, err, &valErr)
+			assert.Equal(t, tt.field, valErr.Field)
+		})
+	}
+}
+
+func TestUpdateDNSRecord_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/domains/records/rec-1", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var req UpdateDNSRecordRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		assert.Equal(t, "76.76.21.22", req.Value)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(DNSRecord{ID: "rec-1", Name: "api", Type: DNSRecordA, Value: req.Value})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	record, err := c.UpdateDNSRecord(context.Background(), "rec-1", UpdateDNSRecordRequest{Type: DNSRecordA, Value: "76.76.21.22"})
+	require.NoError(t, err)
+	assert.Equal(t, "76.76.21.22", record.Value)
+
+	_, err = c.UpdateDNSRecord(context.Background(), "rec-1", UpdateDNSRecordRequest{Type: DNSRecordA, Value: "nope"})
+	require.Error(t, err)
+}
+
+func TestDeleteDNSRecord_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/domains/example.com/records/rec-1", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	err := c.DeleteDNSRecord(context.Background(), "example.com", "rec-1")
+	require.NoError(t, err)
+}
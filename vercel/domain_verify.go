@@ -0,0 +1,198 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Fallback targets used when the config endpoint returns no recommendations.
+const (
+	defaultDomainIPv4  = "76.76.21.21"
+	defaultDomainCNAME = "cname.vercel-dns.com"
+)
+
+// DefaultDomainVerifyInterval is the polling interval WaitForDomainVerified
+// uses when none is given.
+const DefaultDomainVerifyInterval = 10 * time.Second
+
+// DomainInstruction is a DNS record the domain owner must set.
+type DomainInstruction struct {
+	Type   DNSRecordType
+	Name   string // fully qualified
+	Value  string
+	Reason string
+}
+
+// String returns the instruction as a single line, e.g.
+// `TXT _vercel.example.com "vc-domain-verify=..." (verify ownership)`.
+func (i DomainInstruction) String() string {
+	value := i.Value
+	if i.Type == DNSRecordTXT {
+		value = fmt.Sprintf("%q", value)
+	}
+
+	return fmt.Sprintf("%s %s %s (%s)", i.Type, i.Name, value, i.Reason)
+}
+
+// DomainStatus combines the verification state of a project domain with its
+// DNS configuration and the records still needed to make it work.
+type DomainStatus struct {
+	Domain       *Domain
+	Config       *DomainConfig
+	Instructions []DomainInstruction
+}
+
+// Ready reports whether the domain is verified and correctly configured.
+func (s *DomainStatus) Ready() bool {
+	return s.Domain.Verified && (s.Config == nil || !s.Config.Misconfigured)
+}
+
+// String renders the status with one line per required record.
+func (s *DomainStatus) String() string {
+	if s.Ready() {
+		return fmt.Sprintf("%s is verified and configured\n", s.Domain.Name)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s is not ready. Set the following DNS records:\n", s.Domain.Name)
+	for _, inst := range s.Instructions {
+		fmt.Fprintf(&b, "  %s\n", inst)
+	}
+
+	return b.String()
+}
+
+// GetDomainConfig retrieves the DNS configuration status of a domain,
+// including whether it is misconfigured and the records Vercel recommends.
+func (c *Client) GetDomainConfig(ctx context.Context, domainName string) (*DomainConfig, error) {
+	var config DomainConfig
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v6/domains/%s/config", domainName), nil, nil, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// VerifyDomain asks Vercel to verify a project domain and returns its
+// status. A failed verification is not an error: the returned status lists
+// the records that still have to be set.
+func (c *Client) VerifyDomain(ctx context.Context, projectIDOrName, domainName string) (*DomainStatus, error) {
+	var domain Domain
+	err := c.doRequest(ctx, "POST", fmt.Sprintf("/v9/projects/%s/domains/%s/verify", projectIDOrName, domainName), nil, nil, &domain)
+	if apiErr, ok := IsAPIError(err); ok && apiErr.StatusCode == http.StatusBadRequest {
+		return c.GetDomainStatus(ctx, projectIDOrName, domainName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return c.domainStatus(ctx, &domain)
+}
+
+// GetDomainStatus retrieves a project domain and its DNS configuration
+// without triggering verification.
+func (c *Client) GetDomainStatus(ctx context.Context, projectIDOrName, domainName string) (*DomainStatus, error) {
+	domain, err := c.GetDomain(ctx, projectIDOrName, domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.domainStatus(ctx, domain)
+}
+
+// WaitForDomainVerified polls VerifyDomain every interval until the domain is
+// verified and configured or ctx is done. An interval <= 0 means
+// DefaultDomainVerifyInterval. When ctx is done, even while a request is in
+// flight, the last observed status is returned along with the context error
+// so callers can show what is still missing.
+func (c *Client) WaitForDomainVerified(ctx context.Context, projectIDOrName, domainName string, interval time.Duration) (*DomainStatus, error) {
+	if interval <= 0 {
+		interval = DefaultDomainVerifyInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last *DomainStatus
+	for {
+		status, err := c.VerifyDomain(ctx, projectIDOrName, domainName)
+		if err != nil {
+			if ctx.Err() != nil {
+				return last, ctx.Err()
+			}
+			return nil, err
+		}
+		if status.Ready() {
+			return status, nil
+		}
+		last = status
+
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) domainStatus(ctx context.Context, domain *Domain) (*DomainStatus, error) {
+	config, err := c.GetDomainConfig(ctx, domain.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DomainStatus{
+		Domain:       domain,
+		Config:       config,
+		Instructions: domainInstructions(domain, config),
+	}, nil
+}
+
+// domainInstructions lists the verification records of an unverified domain
+// and, when it is misconfigured, the A or CNAME record that points it at
+// Vercel.
+func domainInstructions(domain *Domain, config *DomainConfig) []DomainInstruction {
+	var instructions []DomainInstruction
+	if !domain.Verified {
+		for _, v := range domain.Verification {
+			reason := "verify ownership"
+			if v.Reason != "" {
+				reason = v.Reason
+			}
+			instructions = append(instructions, DomainInstruction{
+				Type:   DNSRecordType(strings.ToUpper(v.Type)),
+				Name:   v.Domain,
+				Value:  v.Value,
+				Reason: reason,
+			})
+		}
+	}
+
+	if config == nil || !config.Misconfigured {
+		return instructions
+	}
+
+	if domain.ApexName == "" || domain.ApexName == domain.Name {
+		value, found := defaultDomainIPv4, false
+		best := 0
+		for _, r := range config.RecommendedIPv4 {
+			if len(r.Value) > 0 && (!found || r.Rank < best) {
+				value, best, found = r.Value[0], r.Rank, true
+			}
+		}
+		instructions = append(instructions, DomainInstruction{Type: DNSRecordA, Name: domain.Name, Value: value, Reason: "point the domain at Vercel"})
+	} else {
+		value, found := defaultDomainCNAME, false
+		best := 0
+		for _, r := range config.RecommendedCNAME {
+			if r.Value != "" && (!found || r.Rank < best) {
+				value, best, found = strings.TrimSuffix(r.Value, "."), r.Rank, true
+			}
+		}
+		instructions = append(instructions, DomainInstruction{Type: DNSRecordCNAME, Name: domain.Name, Value: value, Reason: "point the domain at Vercel"})
+	}
+
+	return instructions
+}
@@ -0,0 +1,137 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDomainConfig_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v6/domains/example.com/config", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"configuredBy":"A","acceptedChallenges":["dns-01","http-01"],"misconfigured":false,"recommendedIPv4":[{"rank":1,"value":["76.76.21.21"]}]}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	config, err := c.GetDomainConfig(context.Background(), "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "A", config.ConfiguredBy)
+	assert.Equal(t, []string{"dns-01", "http-01"}, config.AcceptedChallenges)
+	assert.Equal(t, []string{"76.76.21.21"}, config.RecommendedIPv4[0].Value)
+}
+
+func TestVerifyDomain_NotVerified(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v9/projects/proj-1/domains/www.example.com/verify":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":"missing_txt_record","message":"Domain is not verified"}}`))
+		case "GET /v9/projects/proj-1/domains/www.example.com":
+			json.NewEncoder(w).Encode(Domain{
+				Name:     "www.example.com",
+				ApexName: "example.com",
+				Verification: []DomainVerification{
+					{Type: "TXT", Domain: "_vercel.example.com", Value: "vc-domain-verify=www.example.com,abc"},
+				},
+			})
+		case "GET /v6/domains/www.example.com/config":
+			w.Write([]byte(`{"misconfigured":true,"recommendedCNAME":[{"rank":2,"value":"other.vercel-dns.com."},{"rank":1,"value":"cname.vercel-dns.com."}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	status, err := c.VerifyDomain(context.Background(), "proj-1", "www.example.com")
+	require.NoError(t, err)
+	assert.False(t, status.Ready())
+	assert.Equal(t, []DomainInstruction{
+		{Type: DNSRecordTXT, Name: "_vercel.example.com", Value: "vc-domain-verify=www.example.com,abc", Reason: "verify ownership"},
+		{Type: DNSRecordCNAME, Name: "www.example.com", Value: "cname.vercel-dns.com", Reason: "point the domain at Vercel"},
+	}, status.Instructions)
+	assert.Equal(t, "www.example.com is not ready. Set the following DNS records:\n"+
+		"  TXT _vercel.example.com \"vc-domain-verify=www.example.com,abc\" (verify ownership)\n"+
+		"  CNAME www.example.com cname.vercel-dns.com (point the domain at Vercel)\n", status.String())
+}
+
+func TestWaitForDomainVerified(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v9/projects/proj-1/domains/example.com/verify":
+			attempts++
+			json.NewEncoder(w).Encode(Domain{Name: "example.com", Verified: attempts > 1})
+		case "/v6/domains/example.com/config":
+			w.Write([]byte(`{"misconfigured":false}`))
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	status, err := c.WaitForDomainVerified(context.Background(), "proj-1", "example.com", time.Millisecond)
+	require.NoError(t, err)
+	assert.True(t, status.Ready())
+	assert.Equal(t, 2, attempts)
+}
+
+func TestWaitForDomainVerified_DefaultInterval(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v9/projects/proj-1/domains/example.com/verify":
+			json.NewEncoder(w).Encode(Domain{Name: "example.com", Verified: true})
+		case "/v6/domains/example.com/config":
+			w.Write([]byte(`{"misconfigured":false}`))
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	status, err := c.WaitForDomainVerified(context.Background(), "proj-1", "example.com", 0)
+	require.NoError(t, err)
+	assert.True(t, status.Ready())
+}
+
+func TestWaitForDomainVerified_CanceledInFlight(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v9/projects/proj-1/domains/example.com/verify":
+			attempts++
+			if attempts > 1 {
+				// The caller gives up while the second attempt is in flight.
+				cancel()
+				<-r.Context().Done()
+				return
+			}
+			json.NewEncoder(w).Encode(Domain{Name: "example.com", Verified: false})
+		case "/v6/domains/example.com/config":
+			w.Write([]byte(`{"misconfigured":true}`))
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	status, err := c.WaitForDomainVerified(ctx, "proj-1", "example.com", time.Millisecond)
+	assert.ErrorIs(t, err, context.Canceled)
+	require.NotNil(t, status)
+	assert.False(t, status.Ready())
+	assert.Equal(t, "example.com", status.Domain.Name)
+}
@@ -2,9 +2,18 @@ package vercel
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 )
 
+var redirectStatusCodes = map[int]bool{
+	301: true,
+	302: true,
+	307: true,
+	308: true,
+}
+
 // ListDomains lists all domains for a project.
 func (c *Client) ListDomains(ctx context.Context, projectIDOrName string) ([]Domain, error) {
 	var resp struct {
@@ -28,8 +37,13 @@ func (c *Client) GetDomain(ctx context.Context, projectIDOrName, domainName stri
 	return &domain, nil
 }
 
-// CreateDomain adds a domain to a project.
+// CreateDomain adds a domain to a project. The request is validated before
+// it is sent.
 func (c *Client) CreateDomain(ctx context.Context, projectIDOrName string, req CreateDomainRequest) (*Domain, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	var domain Domain
 	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/v9/projects/%s/domains", projectIDOrName), nil, req, &domain); err != nil {
 		return nil, err
@@ -43,3 +57,80 @@ func (c *Client) DeleteDomain(ctx context.Context, projectIDOrName, domainName s
 	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v9/projects/%s/domains/%s", projectIDOrName, domainName), nil, nil, nil)
 }
 
+// UpdateProjectDomain changes the redirect, redirect status code or git
+// branch of a project domain. The request is validated before it is sent.
+func (c *Client) UpdateProjectDomain(ctx context.Context, projectIDOrName, domainName string, req UpdateProjectDomainRequest) (*Domain, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.Redirect != nil && strings.EqualFold(*req.Redirect, domainName) {
+		return nil, &ValidationError{Field: "redirect", Message: fmt.Sprintf("%s cannot redirect to itself", domainName)}
+	}
+
+	var domain Domain
+	if err := c.doRequest(ctx, "PATCH", fmt.Sprintf("/v9/projects/%s/domains/%s", projectIDOrName, domainName), nil, req, &domain); err != nil {
+		return nil, err
+	}
+
+	return &domain, nil
+}
+
+// Validate checks the redirect settings of the request.
+func (r CreateDomainRequest) Validate() error {
+	if r.Redirect != "" && strings.EqualFold(r.Redirect, r.Name) {
+		return &ValidationError{Field: "redirect", Message: fmt.Sprintf("%s cannot redirect to itself", r.Name)}
+	}
+	if r.RedirectStatusCode != 0 && r.Redirect == "" {
+		return &ValidationError{Field: "redirectStatusCode", Message: "requires redirect"}
+	}
+
+	return validateRedirectStatusCode(r.RedirectStatusCode)
+}
+
+// Validate checks the redirect settings of the request.
+func (r UpdateProjectDomainRequest) Validate() error {
+	if r.RedirectStatusCode == nil {
+		return nil
+	}
+	if r.Redirect != nil && *r.Redirect == "" {
+		return &ValidationError{Field: "redirectStatusCode", Message: "cannot be set while removing the redirect"}
+	}
+
+	return validateRedirectStatusCode(*r.RedirectStatusCode)
+}
+
+// MarshalJSON sends null for fields set to empty strings, which the API
+// treats as removing the redirect or git branch.
+func (r UpdateProjectDomainRequest) MarshalJSON() ([]byte, error) {
+	out := make(map[string]interface{})
+	if r.GitBranch != nil {
+		out["gitBranch"] = nullIfEmpty(*r.GitBranch)
+	}
+	if r.Redirect != nil {
+		out["redirect"] = nullIfEmpty(*r.Redirect)
+		if *r.Redirect == "" {
+			out["redirectStatusCode"] = nil
+		}
+	}
+	if r.RedirectStatusCode != nil {
+		out["redirectStatusCode"] = *r.RedirectStatusCode
+	}
+
+	return json.Marshal(out)
+}
+
+func validateRedirectStatusCode(code int) error {
+	if code != 0 && !redirectStatusCodes[code] {
+		return &ValidationError{Field: "redirectStatusCode", Message: fmt.Sprintf("%d is not one of 301, 302, 307 or 308", code)}
+	}
+
+	return nil
+}
+
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
@@ -134,3 +134,69 @@ func TestGetDomain_NotFound(t *testing.T) {
 	assert.Equal(t, "NOT_FOUND", apiErr.Code)
 }
 
+
+func TestCreateDomain_Redirect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "example.com", body["redirect"])
+		assert.Equal(t, float64(308), body["redirectStatusCode"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Domain{Name: "www.example.com", Redirect: "example.com", RedirectStatusCode: 308})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	domain, err := c.CreateDomain(context.Background(), "proj-1", CreateDomainRequest{
+		Name:               "www.example.com",
+		Redirect:           "example.com",
+		RedirectStatusCode: 308,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 308, domain.RedirectStatusCode)
+}
+
+func TestUpdateProjectDomain_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v9/projects/proj-1/domains/staging.example.com", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{
+			"gitBranch":          "staging",
+			"redirect":           nil,
+			"redirectStatusCode": nil,
+		}, body)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Domain{Name: "staging.example.com", GitBranch: "staging"})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	branch, redirect := "staging", ""
+	domain, err := c.UpdateProjectDomain(context.Background(), "proj-1", "staging.example.com", UpdateProjectDomainRequest{
+		GitBranch: &branch,
+		Redirect:  &redirect,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "staging", domain.GitBranch)
+}
+
+func TestUpdateProjectDomain_InvalidStatusCode(t *testing.T) {
+	c := New("test-token", WithBaseURL("http://unused"))
+
+	redirect, code := "example.com", 303
+	_, err := c.UpdateProjectDomain(context.Background(), "proj-1", "www.example.com", UpdateProjectDomainRequest{
+		Redirect:           &redirect,
+		RedirectStatusCode: &code,
+	})
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "redirectStatusCode", validationErr.Field)
+}
@@ -0,0 +1,182 @@
+package vercel
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var dotenvKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// ParseDotenv parses dotenv-formatted input into a map of keys to values.
+//
+// Blank lines and lines starting with # are ignored, and keys may carry an
+// `export` prefix. Values may be unquoted, single-quoted (literal),
+// double-quoted (with \n, \r, \t, \", \\ and \$ escapes) or backtick-quoted,
+// and quoted values may span multiple lines. Unquoted values end at an
+// inline comment introduced by " #". When a key repeats, the last value wins.
+func ParseDotenv(r io.Reader) (map[string]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &dotenvParser{src: strings.ReplaceAll(string(data), "\r\n", "\n"), line: 1}
+	vars := make(map[string]string)
+	for {
+		key, value, ok, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return vars, nil
+		}
+		vars[key] = value
+	}
+}
+
+type dotenvParser struct {
+	src  string
+	pos  int
+	line int
+}
+
+func (p *dotenvParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("dotenv: line %d: %s", p.line, fmt.Sprintf(format, args...))
+}
+
+// next returns the next assignment, or ok=false at the end of the input.
+func (p *dotenvParser) next() (key, value string, ok bool, err error) {
+	for p.pos < len(p.src) {
+		trimmed := strings.TrimSpace(p.peekLine())
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			p.readLine()
+			p.line++
+			continue
+		}
+
+		key, value, err = p.assignment()
+		return key, value, err == nil, err
+	}
+
+	return "", "", false, nil
+}
+
+// peekLine returns the rest of the current line without consuming it.
+func (p *dotenvParser) peekLine() string {
+	end := strings.IndexByte(p.src[p.pos:], '\n')
+	if end < 0 {
+		return p.src[p.pos:]
+	}
+
+	return p.src[p.pos : p.pos+end]
+}
+
+// readLine consumes up to and including the next newline and returns the
+// line without its terminator.
+func (p *dotenvParser) readLine() string {
+	end := strings.IndexByte(p.src[p.pos:], '\n')
+	if end < 0 {
+		line := p.src[p.pos:]
+		p.pos = len(p.src)
+		return line
+	}
+
+	line := p.src[p.pos : p.pos+end]
+	p.pos += end + 1
+	return line
+}
+
+func (p *dotenvParser) assignment() (string, string, error) {
+	head := p.peekLine()
+
+	eq := strings.IndexByte(head, '=')
+	if eq < 0 {
+		return "", "", p.errorf("expected KEY=VALUE")
+	}
+
+	key := strings.TrimSpace(head[:eq])
+	if strings.HasPrefix(key, "export ") || strings.HasPrefix(key, "export\t") {
+		key = strings.TrimSpace(key[len("export"):])
+	}
+	if !dotenvKeyPattern.MatchString(key) {
+		return "", "", p.errorf("invalid key %q", key)
+	}
+
+	p.pos += eq + 1
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+
+	if p.pos < len(p.src) {
+		switch q := p.src[p.pos]; q {
+		case '"', '\'', '`':
+			value, err := p.quoted(q)
+			return key, value, err
+		}
+	}
+
+	value := p.readLine()
+	p.line++
+	if i := strings.Index(value, " #"); i >= 0 {
+		value = value[:i]
+	}
+	if i := strings.Index(value, "\t#"); i >= 0 {
+		value = value[:i]
+	}
+
+	return key, strings.TrimSpace(value), nil
+}
+
+// quoted parses a value enclosed in the quote character q.
+func (p *dotenvParser) quoted(q byte) (string, error) {
+	start := p.line
+	p.pos++
+
+	var b strings.Builder
+	for {
+		if p.pos >= len(p.src) {
+			p.line = start
+			return "", p.errorf("unterminated %c-quoted value", q)
+		}
+
+		ch := p.src[p.pos]
+		p.pos++
+
+		if ch == q {
+			break
+		}
+		if ch == '\n' {
+			p.line++
+		}
+		if ch == '\\' && q == '"' && p.pos < len(p.src) {
+			esc := p.src[p.pos]
+			p.pos++
+			switch esc {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case '"', '\\', '$':
+				b.WriteByte(esc)
+			default:
+				b.WriteByte('\\')
+				b.WriteByte(esc)
+			}
+			continue
+		}
+
+		b.WriteByte(ch)
+	}
+
+	rest := strings.TrimSpace(p.readLine())
+	if rest != "" && !strings.HasPrefix(rest, "#") {
+		return "", p.errorf("unexpected %q after quoted value", rest)
+	}
+	p.line++
+
+	return b.String(), nil
+}
@@ -0,0 +1,65 @@
+package vercel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDotenv(t *testing.T) {
+	input := `# comment
+PLAIN=value
+export EXPORTED=yes
+SPACED = padded value  
+INLINE=foo # trailing comment
+HASH=foo#bar
+EMPTY=
+SINGLE='literal \n $HOME'
+DOUBLE="line1\nline2 \"quoted\""
+MULTI="first
+second"
+BACKTICK=` + "`it's`" + `
+QUOTED_COMMENT="x" # note
+PLAIN=override
+`
+
+	vars, err := ParseDotenv(strings.NewReader(input))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"PLAIN":          "override",
+		"EXPORTED":       "yes",
+		"SPACED":         "padded value",
+		"INLINE":         "foo",
+		"HASH":           "foo#bar",
+		"EMPTY":          "",
+		"SINGLE":         `literal \n $HOME`,
+		"DOUBLE":         "line1\nline2 \"quoted\"",
+		"MULTI":          "first\nsecond",
+		"BACKTICK":       "it's",
+		"QUOTED_COMMENT": "x",
+	}, vars)
+}
+
+func TestParseDotenv_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		line  string
+	}{
+		{name: "missing equals", input: "A=1\nNOPE\n", line: "line 2"},
+		{name: "invalid key", input: "1A=1\n", line: "line 1"},
+		{name: "unterminated quote", input: "A=1\nB=\"open\nC=2\n", line: "line 2"},
+		{name: "trailing garbage", input: "A='x' y\n", line: "line 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseDotenv(strings.NewReader(tt.input))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.line)
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+// edgeConfigKeyPattern matches valid Edge Config slugs and item keys.
+var edgeConfigKeyPattern = regexp.MustCompile(`^[\w-]+$`)
+
+// ListEdgeConfigs lists the Edge Config stores of the authenticated user or team.
+func (c *Client) ListEdgeConfigs(ctx context.Context) ([]EdgeConfig, error) {
+	var configs []EdgeConfig
+	if err := c.doRequest(ctx, "GET", "/v1/edge-config", nil, nil, &configs); err != nil {
+		return nil, err
+	}
+
+	return configs, nil
+}
+
+// GetEdgeConfig retrieves an Edge Config store by ID.
+func (c *Client) GetEdgeConfig(ctx context.Context, edgeConfigID string) (*EdgeConfig, error) {
+	var config EdgeConfig
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v1/edge-config/%s", edgeConfigID), nil, nil, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// CreateEdgeConfig creates an Edge Config store.
+func (c *Client) CreateEdgeConfig(ctx context.Context, req CreateEdgeConfigRequest) (*EdgeConfig, error) {
+	if err := validateEdgeConfigSlug(req.Slug); err != nil {
+		return nil, err
+	}
+	for key := range req.Items {
+		if err := validateEdgeConfigKey(key); err != nil {
+			return nil, err
+		}
+	}
+
+	var config EdgeConfig
+	if err := c.doRequest(ctx, "POST", "/v1/edge-config", nil, req, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// UpdateEdgeConfig renames an Edge Config store.
+func (c *Client) UpdateEdgeConfig(ctx context.Context, edgeConfigID, slug string) (*EdgeConfig, error) {
+	if err := validateEdgeConfigSlug(slug); err != nil {
+		return nil, err
+	}
+
+	body := struct {
+		Slug string `json:"slug"`
+	}{Slug: slug}
+
+	var config EdgeConfig
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/v1/edge-config/%s", edgeConfigID), nil, body, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// DeleteEdgeConfig deletes an Edge Config store by ID.
+func (c *Client) DeleteEdgeConfig(ctx context.Context, edgeConfigID string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v1/edge-config/%s", edgeConfigID), nil, nil, nil)
+}
+
+// ListEdgeConfigItems lists all items of an Edge Config store.
+func (c *Client) ListEdgeConfigItems(ctx context.Context, edgeConfigID string) ([]EdgeConfigItem, error) {
+	var items []EdgeConfigItem
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v1/edge-config/%s/items", edgeConfigID), nil, nil, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// GetEdgeConfigItem retrieves an item of an Edge Config store by key.
+func (c *Client) GetEdgeConfigItem(ctx context.Context, edgeConfigID, key string) (*EdgeConfigItem, error) {
+	var item EdgeConfigItem
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v1/edge-config/%s/item/%s", edgeConfigID, key), nil, nil, &item); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// PatchEdgeConfigItems applies a batch of item operations to an Edge Config
+// store in one request.
+func (c *Client) PatchEdgeConfigItems(ctx context.Context, edgeConfigID string, patches []EdgeConfigItemPatch) error {
+	if len(patches) == 0 {
+		return &ValidationError{Field: "items", Message: "at least one operation is required"}
+	}
+	for _, p := range patches {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+
+	body := struct {
+		Items []EdgeConfigItemPatch `json:"items"`
+	}{Items: patches}
+
+	return c.doRequest(ctx, "PATCH", fmt.Sprintf("/v1/edge-config/%s/items", edgeConfigID), nil, body, nil)
+}
+
+// GetEdgeConfigSchema retrieves the JSON schema attached to an Edge Config
+// store.
+func (c *Client) GetEdgeConfigSchema(ctx context.Context, edgeConfigID string) (json.RawMessage, error) {
+	var schema json.RawMessage
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v1/edge-config/%s/schema", edgeConfigID), nil, nil, &schema); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+// UpdateEdgeConfigSchema attaches a JSON schema to an Edge Config store,
+// replacing any previous one. Item updates that do not match the schema are
+// rejected by Vercel.
+func (c *Client) UpdateEdgeConfigSchema(ctx context.Context, edgeConfigID string, definition interface{}) error {
+	if definition == nil {
+		return &ValidationError{Field: "definition", Message: "is required"}
+	}
+
+	body := struct {
+		Definition interface{} `json:"definition"`
+	}{Definition: definition}
+
+	return c.doRequest(ctx, "PATCH", fmt.Sprintf("/v1/edge-config/%s/schema", edgeConfigID), nil, body, nil)
+}
+
+// DeleteEdgeConfigSchema detaches the JSON schema from an Edge Config store.
+func (c *Client) DeleteEdgeConfigSchema(ctx context.Context, edgeConfigID string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v1/edge-config/%s/schema", edgeConfigID), nil, nil, nil)
+}
+
+// ListEdgeConfigTokens lists the read tokens of an Edge Config store.
+func (c *Client) ListEdgeConfigTokens(ctx context.Context, edgeConfigID string) ([]EdgeConfigToken, error) {
+	var tokens []EdgeConfigToken
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v1/edge-config/%s/tokens", edgeConfigID), nil, nil, &tokens); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// GetEdgeConfigToken retrieves a read token of an Edge Config store.
+func (c *Client) GetEdgeConfigToken(ctx context.Context, edgeConfigID, token string) (*EdgeConfigToken, error) {
+	var t EdgeConfigToken
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v1/edge-config/%s/token/%s", edgeConfigID, token), nil, nil, &t); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// CreateEdgeConfigToken creates a labeled read token for an Edge Config store.
+func (c *Client) CreateEdgeConfigToken(ctx context.Context, edgeConfigID, label string) (*EdgeConfigToken, error) {
+	if label == "" {
+		return nil, &ValidationError{Field: "label", Message: "is required"}
+	}
+
+	body := struct {
+		Label string `json:"label"`
+	}{Label: label}
+
+	var t EdgeConfigToken
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/v1/edge-config/%s/token", edgeConfigID), nil, body, &t); err != nil {
+		return nil, err
+	}
+	t.Label = label
+	t.EdgeConfigID = edgeConfigID
+
+	return &t, nil
+}
+
+// DeleteEdgeConfigTokens revokes read tokens of an Edge Config store.
+func (c *Client) DeleteEdgeConfigTokens(ctx context.Context, edgeConfigID string, tokens []string) error {
+	if len(tokens) == 0 {
+		return &ValidationError{Field: "tokens", Message: "at least one token is required"}
+	}
+
+	body := struct {
+		Tokens []string `json:"tokens"`
+	}{Tokens: tokens}
+
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v1/edge-config/%s/tokens", edgeConfigID), nil, body, nil)
+}
+
+// DecodeValue decodes the item value into v.
+func (i EdgeConfigItem) DecodeValue(v interface{}) error {
+	if len(i.Value) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(i.Value, v)
+}
+
+// GetEdgeConfigItem retrieves an item of an Edge Config store and decodes
+// its value into a T.
+func GetEdgeConfigItem[T any](ctx context.Context, c *Client, edgeConfigID, key string) (T, error) {
+	var value T
+
+	item, err := c.GetEdgeConfigItem(ctx, edgeConfigID, key)
+	if err != nil {
+		return value, err
+	}
+	if err := item.DecodeValue(&value); err != nil {
+		return value, fmt.Errorf("failed to decode edge config item %s: %w", key, err)
+	}
+
+	return value, nil
+}
+
+// GetEdgeConfigItems retrieves all items of an Edge Config store and decodes
+// their values into Ts, keyed by item key. Every item must decode into a T.
+func GetEdgeConfigItems[T any](ctx context.Context, c *Client, edgeConfigID string) (map[string]T, error) {
+	items, err := c.ListEdgeConfigItems(ctx, edgeConfigID)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]T, len(items))
+	for _, item := range items {
+		var value T
+		if err := item.DecodeValue(&value); err != nil {
+			return nil, fmt.Errorf("failed to decode edge config item %s: %w", item.Key, err)
+		}
+		values[item.Key] = value
+	}
+
+	return values, nil
+}
+
+// Validate checks the operation and key of the patch, and that every
+// operation but delete carries a value.
+func (p EdgeConfigItemPatch) Validate() error {
+	switch p.Operation {
+	case EdgeConfigItemCreate, EdgeConfigItemUpdate, EdgeConfigItemUpsert:
+		if p.Value == nil {
+			return &ValidationError{Field: "value", Message: fmt.Sprintf("is required to %s %s", p.Operation, p.Key)}
+		}
+	case EdgeConfigItemDelete:
+	default:
+		return &ValidationError{Field: "operation", Message: fmt.Sprintf("unknown operation %q", p.Operation)}
+	}
+
+	return validateEdgeConfigKey(p.Key)
+}
+
+func validateEdgeConfigSlug(slug string) error {
+	if len(slug) == 0 || len(slug) > 64 || !edgeConfigKeyPattern.MatchString(slug) {
+		return &ValidationError{Field: "slug", Message: "must be 1-64 letters, digits, underscores, or hyphens"}
+	}
+
+	return nil
+}
+
+func validateEdgeConfigKey(key string) error {
+	if len(key) == 0 || len(key) > 256 || !edgeConfigKeyPattern.MatchString(key) {
+		return &ValidationError{Field: "key", Message: fmt.Sprintf("%q must be 1-256 letters, digits, underscores, or hyphens", key)}
+	}
+
+	return nil
+}
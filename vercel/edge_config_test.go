@@ -0,0 +1,174 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateEdgeConfig_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/edge-config", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "flags", body["slug"])
+		assert.Equal(t, map[string]interface{}{"beta": true}, body["items"])
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id":"ecfg-1","slug":"flags","itemCount":1}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	config, err := c.CreateEdgeConfig(context.Background(), CreateEdgeConfigRequest{
+		Slug:  "flags",
+		Items: map[string]interface{}{"beta": true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ecfg-1", config.ID)
+
+	_, err = c.CreateEdgeConfig(context.Background(), CreateEdgeConfigRequest{Slug: "feature flags"})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "slug", validationErr.Field)
+}
+
+func TestPatchEdgeConfigItems_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/edge-config/ecfg-1/items", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body struct {
+			Items []map[string]interface{} `json:"items"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		require.Len(t, body.Items, 3)
+		assert.Equal(t, map[string]interface{}{"operation": "upsert", "key": "beta", "value": false}, body.Items[0])
+		assert.Equal(t, map[string]interface{}{"operation": "create", "key": "rollout", "value": map[string]interface{}{"percent": float64(10)}}, body.Items[1])
+		assert.Equal(t, map[string]interface{}{"operation": "delete", "key": "legacy"}, body.Items[2])
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	err := c.PatchEdgeConfigItems(context.Background(), "ecfg-1", []EdgeConfigItemPatch{
+		{Operation: EdgeConfigItemUpsert, Key: "beta", Value: false},
+		{Operation: EdgeConfigItemCreate, Key: "rollout", Value: map[string]int{"percent": 10}},
+		{Operation: EdgeConfigItemDelete, Key: "legacy"},
+	})
+	require.NoError(t, err)
+}
+
+func TestPatchEdgeConfigItems_Validation(t *testing.T) {
+	c := New("test-token")
+
+	tests := []struct {
+		name  string
+		patch EdgeConfigItemPatch
+		field string
+	}{
+		{"unknown operation", EdgeConfigItemPatch{Operation: "merge", Key: "beta", Value: true}, "operation"},
+		{"missing value", EdgeConfigItemPatch{Operation: EdgeConfigItemUpdate, Key: "beta"}, "value"},
+		{"invalid key", EdgeConfigItemPatch{Operation: EdgeConfigItemDelete, Key: "a.b"}, "key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.PatchEdgeConfigItems(context.Background(), "ecfg-1", []EdgeConfigItemPatch{tt.patch})
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestGetEdgeConfigItemTyped(t *testing.T) {
+	type rollout struct {
+		Percent int      `json:"percent"`
+		Regions []string `json:"regions"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/edge-config/ecfg-1/item/rollout":
+			w.Write([]byte(`{"key":"rollout","value":{"percent":25,"regions":["iad1"]},"edgeConfigId":"ecfg-1"}`))
+		case "/v1/edge-config/ecfg-1/items":
+			w.Write([]byte(`[{"key":"beta","value":true},{"key":"dark-mode","value":false}]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	value, err := GetEdgeConfigItem[rollout](context.Background(), c, "ecfg-1", "rollout")
+	require.NoError(t, err)
+	assert.Equal(t, rollout{Percent: 25, Regions: []string{"iad1"}}, value)
+
+	flags, err := GetEdgeConfigItems[bool](context.Background(), c, "ecfg-1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"beta": true, "dark-mode": false}, flags)
+
+	_, err = GetEdgeConfigItem[int](context.Background(), c, "ecfg-1", "rollout")
+	assert.ErrorContains(t, err, "failed to decode edge config item rollout")
+}
+
+func TestUpdateEdgeConfigSchema_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/edge-config/ecfg-1/schema", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"type": "object"}, body["definition"])
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	require.NoError(t, c.UpdateEdgeConfigSchema(context.Background(), "ecfg-1", map[string]string{"type": "object"}))
+}
+
+func TestEdgeConfigTokens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/v1/edge-config/ecfg-1/token":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "edge-runtime", body["label"])
+			w.Write([]byte(`{"id":"tok-1","token":"secret-token"}`))
+		case r.Method == "DELETE" && r.URL.Path == "/v1/edge-config/ecfg-1/tokens":
+			var body map[string][]string
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, []string{"secret-token"}, body["tokens"])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	token, err := c.CreateEdgeConfigToken(context.Background(), "ecfg-1", "edge-runtime")
+	require.NoError(t, err)
+	assert.Equal(t, "secret-token", token.Token)
+	assert.Equal(t, "edge-runtime", token.Label)
+
+	require.NoError(t, c.DeleteEdgeConfigTokens(context.Background(), "ecfg-1", []string{token.Token}))
+}
@@ -3,23 +3,52 @@ package vercel
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 // ListEnvVars lists all environment variables for a project.
 func (c *Client) ListEnvVars(ctx context.Context, projectIDOrName string) ([]EnvVar, error) {
+	return c.ListEnvVarsWithOptions(ctx, projectIDOrName, ListEnvVarsOptions{})
+}
+
+// ListEnvVarsWithOptions lists environment variables for a project,
+// optionally decrypting their values or filtering by git branch.
+func (c *Client) ListEnvVarsWithOptions(ctx context.Context, projectIDOrName string, opts ListEnvVarsOptions) ([]EnvVar, error) {
+	query := make(map[string]string)
+	if opts.Decrypt {
+		query["decrypt"] = "true"
+	}
+	if opts.GitBranch != "" {
+		query["gitBranch"] = opts.GitBranch
+	}
+
 	var resp struct {
 		EnvVars []EnvVar `json:"env"`
 	}
 
-	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v9/projects/%s/env", projectIDOrName), nil, nil, &resp); err != nil {
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v9/projects/%s/env", projectIDOrName), query, nil, &resp); err != nil {
 		return nil, err
 	}
 
 	return resp.EnvVars, nil
 }
 
+// GetEnvVar retrieves an environment variable by ID with its value decrypted.
+func (c *Client) GetEnvVar(ctx context.Context, projectIDOrName, envID string) (*EnvVar, error) {
+	var envVar EnvVar
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v1/projects/%s/env/%s", projectIDOrName, envID), nil, nil, &envVar); err != nil {
+		return nil, err
+	}
+
+	return &envVar, nil
+}
+
 // CreateEnvVar creates a new environment variable for a project.
 func (c *Client) CreateEnvVar(ctx context.Context, projectIDOrName string, req CreateEnvVarRequest) (*EnvVar, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	var envVar EnvVar
 	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/v9/projects/%s/env", projectIDOrName), nil, req, &envVar); err != nil {
 		return nil, err
@@ -30,6 +59,10 @@ func (c *Client) CreateEnvVar(ctx context.Context, projectIDOrName string, req C
 
 // UpdateEnvVar updates an environment variable by ID.
 func (c *Client) UpdateEnvVar(ctx context.Context, projectIDOrName, envID string, req UpdateEnvVarRequest) (*EnvVar, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	var envVar EnvVar
 	if err := c.doRequest(ctx, "PATCH", fmt.Sprintf("/v9/projects/%s/env/%s", projectIDOrName, envID), nil, req, &envVar); err != nil {
 		return nil, err
@@ -42,3 +75,60 @@ func (c *Client) UpdateEnvVar(ctx context.Context, projectIDOrName, envID string
 func (c *Client) DeleteEnvVar(ctx context.Context, projectIDOrName, envID string) error {
 	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v9/projects/%s/env/%s", projectIDOrName, envID), nil, nil, nil)
 }
+
+// Validate checks the request for combinations the API rejects, such as a
+// sensitive variable targeting development or a git branch outside preview.
+func (r CreateEnvVarRequest) Validate() error {
+	if r.Key == "" {
+		return &ValidationError{Field: "key", Message: "must not be empty"}
+	}
+	if strings.ContainsAny(r.Key, "= \t\n") {
+		return &ValidationError{Field: "key", Message: fmt.Sprintf("%q contains invalid characters", r.Key)}
+	}
+	if len(r.Target) == 0 && len(r.CustomEnvironmentIDs) == 0 {
+		return &ValidationError{Field: "target", Message: "at least one target or custom environment is required"}
+	}
+	if r.GitBranch != "" && len(r.Target) == 0 {
+		return &ValidationError{Field: "gitBranch", Message: "requires the preview target only"}
+	}
+
+	return validateEnvVarFields(r.Type, r.Target, r.GitBranch, true)
+}
+
+// Validate checks the request for combinations the API rejects. Fields left
+// empty are not changed by the update and are not checked.
+func (r UpdateEnvVarRequest) Validate() error {
+	if r.Key != "" && strings.ContainsAny(r.Key, "= \t\n") {
+		return &ValidationError{Field: "key", Message: fmt.Sprintf("%q contains invalid characters", r.Key)}
+	}
+
+	return validateEnvVarFields(r.Type, r.Target, r.GitBranch, r.Type != "")
+}
+
+func validateEnvVarFields(envType EnvType, targets []EnvTarget, gitBranch string, checkType bool) error {
+	if checkType {
+		switch envType {
+		case EnvTypePlain, EnvTypeEncrypted, EnvTypeSensitive, EnvTypeSecret, EnvTypeSystem:
+		default:
+			return &ValidationError{Field: "type", Message: fmt.Sprintf("unknown type %q", envType)}
+		}
+	}
+
+	for _, t := range targets {
+		switch t {
+		case EnvTargetProduction, EnvTargetPreview, EnvTargetDevelopment:
+		default:
+			return &ValidationError{Field: "target", Message: fmt.Sprintf("unknown target %q", t)}
+		}
+
+		if t == EnvTargetDevelopment && envType == EnvTypeSensitive {
+			return &ValidationError{Field: "type", Message: "sensitive variables cannot target development"}
+		}
+	}
+
+	if gitBranch != "" && len(targets) > 0 && (len(targets) != 1 || targets[0] != EnvTargetPreview) {
+		return &ValidationError{Field: "gitBranch", Message: "requires the preview target only"}
+	}
+
+	return nil
+}
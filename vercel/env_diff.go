@@ -0,0 +1,201 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// EnvScope identifies the environment variables of a project that apply to
+// a target and, for preview, optionally a single git branch.
+type EnvScope struct {
+	Project   string
+	Target    EnvTarget
+	GitBranch string
+}
+
+// String returns the scope as project/target[/branch].
+func (s EnvScope) String() string {
+	if s.GitBranch != "" {
+		return fmt.Sprintf("%s/%s/%s", s.Project, s.Target, s.GitBranch)
+	}
+
+	return fmt.Sprintf("%s/%s", s.Project, s.Target)
+}
+
+// EnvDiffEntry pairs the source and destination variables for a key.
+type EnvDiffEntry struct {
+	Key string
+	Src *EnvVar // nil when removed
+	Dst *EnvVar // nil when added
+}
+
+// EnvDiff reports how the variables of a destination scope differ from a
+// source scope.
+type EnvDiff struct {
+	Src, Dst EnvScope
+	Added    []EnvDiffEntry // only in the source
+	Removed  []EnvDiffEntry // only in the destination
+	Changed  []EnvDiffEntry // in both with different values
+	// Incomparable lists keys present in both whose values could not both
+	// be decrypted, such as sensitive variables.
+	Incomparable []EnvDiffEntry
+}
+
+// EnvPromoteOptions configures PromoteEnvVars.
+type EnvPromoteOptions struct {
+	// Filter selects the keys to promote. All keys are promoted when nil.
+	Filter func(key string) bool
+	// Delete removes destination variables that are missing from the source.
+	Delete bool
+	// DryRun computes the changes without applying them.
+	DryRun bool
+}
+
+// EnvPromotion reports the changes made, or planned, by PromoteEnvVars.
+type EnvPromotion struct {
+	Diff    *EnvDiff
+	Changes []EnvChange
+	// Skipped lists keys that could not be promoted because the source
+	// value could not be decrypted.
+	Skipped []string
+}
+
+// DiffEnvVars compares the environment variables of two scopes, which may
+// belong to different projects. Values are compared after decryption.
+func (c *Client) DiffEnvVars(ctx context.Context, src, dst EnvScope) (*EnvDiff, error) {
+	srcVars, err := c.listScopedEnvVars(ctx, src)
+	if err != nil {
+		return nil, err
+	}
+
+	dstVars, err := c.listScopedEnvVars(ctx, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &EnvDiff{Src: src, Dst: dst}
+	for key, s := range srcVars {
+		d, ok := dstVars[key]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, EnvDiffEntry{Key: key, Src: s})
+		case !envValueKnown(*s) || !envValueKnown(*d):
+			diff.Incomparable = append(diff.Incomparable, EnvDiffEntry{Key: key, Src: s, Dst: d})
+		case s.Value != d.Value:
+			diff.Changed = append(diff.Changed, EnvDiffEntry{Key: key, Src: s, Dst: d})
+		}
+	}
+	for key, d := range dstVars {
+		if _, ok := srcVars[key]; !ok {
+			diff.Removed = append(diff.Removed, EnvDiffEntry{Key: key, Dst: d})
+		}
+	}
+
+	for _, entries := range [][]EnvDiffEntry{diff.Added, diff.Removed, diff.Changed, diff.Incomparable} {
+		sortEnvDiffEntries(entries)
+	}
+
+	return diff, nil
+}
+
+// PromoteEnvVars copies environment variables from one scope to another,
+// creating added keys and updating changed ones. Created and updated
+// variables take the source type. Keys whose source value cannot be
+// decrypted are skipped. On failure the promotion is returned along with the
+// error.
+func (c *Client) PromoteEnvVars(ctx context.Context, src, dst EnvScope, opts EnvPromoteOptions) (*EnvPromotion, error) {
+	diff, err := c.DiffEnvVars(ctx, src, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	include := func(key string) bool {
+		return opts.Filter == nil || opts.Filter(key)
+	}
+
+	promotion := &EnvPromotion{Diff: diff}
+	for _, e := range diff.Added {
+		if !include(e.Key) {
+			continue
+		}
+		if !envValueKnown(*e.Src) {
+			promotion.Skipped = append(promotion.Skipped, e.Key)
+			continue
+		}
+		promotion.Changes = append(promotion.Changes, EnvChange{Action: EnvChangeCreate, Key: e.Key, Value: e.Src.Value, Type: e.Src.Type})
+	}
+	for _, e := range diff.Changed {
+		if include(e.Key) {
+			promotion.Changes = append(promotion.Changes, EnvChange{Action: EnvChangeUpdate, Key: e.Key, Value: e.Src.Value, Type: e.Src.Type, Existing: e.Dst})
+		}
+	}
+	for _, e := range diff.Incomparable {
+		if include(e.Key) {
+			promotion.Skipped = append(promotion.Skipped, e.Key)
+		}
+	}
+	if opts.Delete {
+		for _, e := range diff.Removed {
+			if include(e.Key) {
+				promotion.Changes = append(promotion.Changes, EnvChange{Action: EnvChangeDelete, Key: e.Key, Existing: e.Dst})
+			}
+		}
+	}
+
+	sort.Slice(promotion.Changes, func(i, j int) bool {
+		return promotion.Changes[i].Key < promotion.Changes[j].Key
+	})
+	sort.Strings(promotion.Skipped)
+
+	if opts.DryRun {
+		return promotion, nil
+	}
+
+	for _, ch := range promotion.Changes {
+		if err := c.applyEnvChange(ctx, dst, EnvTypeEncrypted, ch); err != nil {
+			return promotion, fmt.Errorf("failed to %s %s in %s: %w", ch.Action, ch.Key, dst, err)
+		}
+	}
+
+	return promotion, nil
+}
+
+// listScopedEnvVars returns the decrypted variables of a scope keyed by name.
+func (c *Client) listScopedEnvVars(ctx context.Context, scope EnvScope) (map[string]*EnvVar, error) {
+	envVars, err := c.ListEnvVarsWithOptions(ctx, scope.Project, ListEnvVarsOptions{
+		Decrypt:   true,
+		GitBranch: scope.GitBranch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make(map[string]*EnvVar)
+	for i := range envVars {
+		ev := &envVars[i]
+		if ev.GitBranch != scope.GitBranch || !envTargetsOverlap(ev.Target, []EnvTarget{scope.Target}) {
+			continue
+		}
+		if _, ok := vars[ev.Key]; !ok {
+			vars[ev.Key] = ev
+		}
+	}
+
+	return vars, nil
+}
+
+// envValueKnown reports whether the value of a listed variable is plaintext.
+func envValueKnown(ev EnvVar) bool {
+	if ev.Type == EnvTypeSensitive || ev.Type == EnvTypeSecret {
+		return false
+	}
+
+	return ev.Type == EnvTypePlain || ev.Decrypted
+}
+
+func sortEnvDiffEntries(entries []EnvDiffEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+}
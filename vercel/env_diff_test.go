@@ -0,0 +1,116 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func envDiffServer(t *testing.T, calls *[]string) *httptest.Server {
+	prod := []EnvTarget{EnvTargetProduction}
+	envs := map[string][]EnvVar{
+		"/v9/projects/tmpl/env": {
+			{ID: "s-add", Key: "ADDED", Value: "a", Type: EnvTypePlain, Target: prod},
+			{ID: "s-sens", Key: "SENSITIVE", Type: EnvTypeSensitive, Target: prod},
+			{ID: "s-chg", Key: "CHANGED", Value: "new", Type: EnvTypeEncrypted, Target: prod, Decrypted: true},
+			{ID: "s-same", Key: "SAME", Value: "x", Type: EnvTypePlain, Target: prod},
+			{ID: "s-inc", Key: "OPAQUE", Type: EnvTypeSensitive, Target: prod},
+			{ID: "s-skip", Key: "FILTERED", Value: "f", Type: EnvTypePlain, Target: prod},
+			{ID: "s-prev", Key: "PREVIEW_ONLY", Value: "p", Type: EnvTypePlain, Target: []EnvTarget{EnvTargetPreview}},
+		},
+		"/v9/projects/app/env": {
+			{ID: "d-chg", Key: "CHANGED", Value: "old", Type: EnvTypePlain, Target: prod},
+			{ID: "d-same", Key: "SAME", Value: "x", Type: EnvTypePlain, Target: prod},
+			{ID: "d-inc", Key: "OPAQUE", Type: EnvTypeSensitive, Target: prod},
+			{ID: "d-rm", Key: "REMOVED", Value: "r", Type: EnvTypePlain, Target: prod},
+		},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			assert.Equal(t, "true", r.URL.Query().Get("decrypt"))
+			json.NewEncoder(w).Encode(map[string]interface{}{"env": envs[r.URL.Path]})
+			return
+		}
+
+		*calls = append(*calls, r.Method+" "+r.URL.Path)
+		if r.Method == "POST" {
+			var req CreateEnvVarRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			assert.Equal(t, EnvTypePlain, req.Type)
+		}
+		if r.Method == "PATCH" {
+			// The destination variable takes the source type along with
+			// its value.
+			var req UpdateEnvVarRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			assert.Equal(t, "new", req.Value)
+			assert.Equal(t, EnvTypeEncrypted, req.Type)
+		}
+		json.NewEncoder(w).Encode(EnvVar{ID: "env-x"})
+	}))
+}
+
+func keysOf(entries []EnvDiffEntry) []string {
+	keys := make([]string, len(entries))
+	for i, e := range entries {
+		keys[i] = e.Key
+	}
+	return keys
+}
+
+func TestDiffEnvVars(t *testing.T) {
+	var calls []string
+	server := envDiffServer(t, &calls)
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	src := EnvScope{Project: "tmpl", Target: EnvTargetProduction}
+	dst := EnvScope{Project: "app", Target: EnvTargetProduction}
+	diff, err := c.DiffEnvVars(context.Background(), src, dst)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ADDED", "FILTERED", "SENSITIVE"}, keysOf(diff.Added))
+	assert.Equal(t, []string{"REMOVED"}, keysOf(diff.Removed))
+	assert.Equal(t, []string{"CHANGED"}, keysOf(diff.Changed))
+	assert.Equal(t, []string{"OPAQUE"}, keysOf(diff.Incomparable))
+	assert.Empty(t, calls)
+}
+
+func TestPromoteEnvVars(t *testing.T) {
+	var calls []string
+	server := envDiffServer(t, &calls)
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	src := EnvScope{Project: "tmpl", Target: EnvTargetProduction}
+	dst := EnvScope{Project: "app", Target: EnvTargetProduction}
+	opts := EnvPromoteOptions{
+		Filter: func(key string) bool { return !strings.HasPrefix(key, "FILTERED") },
+		Delete: true,
+		DryRun: true,
+	}
+
+	promotion, err := c.PromoteEnvVars(context.Background(), src, dst, opts)
+	require.NoError(t, err)
+	assert.Empty(t, calls)
+	require.Len(t, promotion.Changes, 3)
+	assert.Equal(t, []string{"OPAQUE", "SENSITIVE"}, promotion.Skipped)
+
+	opts.DryRun = false
+	_, err = c.PromoteEnvVars(context.Background(), src, dst, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"POST /v9/projects/app/env",
+		"PATCH /v9/projects/app/env/d-chg",
+		"DELETE /v9/projects/app/env/d-rm",
+	}, calls)
+}
@@ -0,0 +1,112 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// EnvFormat is an output format for exported environment variables.
+type EnvFormat string
+
+const (
+	EnvFormatDotenv EnvFormat = "dotenv" // KEY="value"
+	EnvFormatJSON   EnvFormat = "json"   // {"KEY": "value"}
+	EnvFormatShell  EnvFormat = "shell"  // export KEY='value'
+)
+
+// PullEnvVars returns the decrypted environment variables that apply to a
+// target, like `vercel env pull`. When gitBranch is set, variables scoped to
+// that branch are included and take precedence over the target-wide ones.
+// Variables whose values cannot be decrypted are returned with empty values.
+func (c *Client) PullEnvVars(ctx context.Context, projectIDOrName string, target EnvTarget, gitBranch string) (map[string]string, error) {
+	envVars, err := c.ListEnvVarsWithOptions(ctx, projectIDOrName, ListEnvVarsOptions{
+		Decrypt:   true,
+		GitBranch: gitBranch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make(map[string]string)
+	branchVars := make(map[string]string)
+	for _, ev := range envVars {
+		if !envTargetsOverlap(ev.Target, []EnvTarget{target}) {
+			continue
+		}
+
+		switch ev.GitBranch {
+		case "":
+			vars[ev.Key] = ev.Value
+		case gitBranch:
+			branchVars[ev.Key] = ev.Value
+		}
+	}
+
+	for key, value := range branchVars {
+		vars[key] = value
+	}
+
+	return vars, nil
+}
+
+// WriteEnvVars writes variables to w in the given format, sorted by key.
+func WriteEnvVars(w io.Writer, vars map[string]string, format EnvFormat) error {
+	switch format {
+	case EnvFormatDotenv, EnvFormatShell, EnvFormatJSON:
+	default:
+		return fmt.Errorf("unsupported env format %q", format)
+	}
+
+	if format == EnvFormatJSON {
+		data, err := json.MarshalIndent(vars, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", data)
+		return err
+	}
+
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		var line string
+		switch format {
+		case EnvFormatDotenv:
+			line = fmt.Sprintf("%s=%s\n", key, quoteDotenv(vars[key]))
+		case EnvFormatShell:
+			line = fmt.Sprintf("export %s=%s\n", key, quoteShell(vars[key]))
+		}
+
+		if _, err := io.WriteString(w, line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// quoteDotenv double-quotes a value using the escapes understood by ParseDotenv.
+func quoteDotenv(value string) string {
+	r := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"$", `\$`,
+	)
+
+	return `"` + r.Replace(value) + `"`
+}
+
+// quoteShell single-quotes a value for POSIX shells.
+func quoteShell(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,68 @@
+package vercel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullEnvVars_BranchOverride(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("decrypt"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"env": []EnvVar{
+				{Key: "API_URL", Value: "https://preview", Target: []EnvTarget{EnvTargetPreview}},
+				{Key: "API_URL", Value: "https://feature", Target: []EnvTarget{EnvTargetPreview}, GitBranch: "feature-x"},
+				{Key: "OTHER_BRANCH", Value: "x", Target: []EnvTarget{EnvTargetPreview}, GitBranch: "other"},
+				{Key: "PROD_ONLY", Value: "x", Target: []EnvTarget{EnvTargetProduction}},
+				{Key: "SHARED", Value: "s", Target: []EnvTarget{EnvTargetProduction, EnvTargetPreview}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	vars, err := c.PullEnvVars(context.Background(), "proj-1", EnvTargetPreview, "feature-x")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"API_URL": "https://feature",
+		"SHARED":  "s",
+	}, vars)
+}
+
+func TestWriteEnvVars_Formats(t *testing.T) {
+	vars := map[string]string{
+		"B": "it's $HOME",
+		"A": "line1\nline2 \"q\"",
+	}
+
+	var dotenv bytes.Buffer
+	require.NoError(t, WriteEnvVars(&dotenv, vars, EnvFormatDotenv))
+	assert.Equal(t, "A=\"line1\\nline2 \\\"q\\\"\"\nB=\"it's \\$HOME\"\n", dotenv.String())
+
+	parsed, err := ParseDotenv(&dotenv)
+	require.NoError(t, err)
+	assert.Equal(t, vars, parsed)
+
+	var shell bytes.Buffer
+	require.NoError(t, WriteEnvVars(&shell, vars, EnvFormatShell))
+	assert.Equal(t, "export A='line1\nline2 \"q\"'\nexport B='it'\\''s $HOME'\n", shell.String())
+
+	var js bytes.Buffer
+	require.NoError(t, WriteEnvVars(&js, vars, EnvFormatJSON))
+	var decoded map[string]string
+	require.NoError(t, json.Unmarshal(js.Bytes(), &decoded))
+	assert.Equal(t, vars, decoded)
+
+	assert.Error(t, WriteEnvVars(&js, vars, EnvFormat("yaml")))
+	assert.Error(t, WriteEnvVars(&js, map[string]string{}, EnvFormat("yaml")))
+}
@@ -0,0 +1,256 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// EnvChangeAction describes a planned change to an environment variable.
+type EnvChangeAction string
+
+const (
+	EnvChangeCreate EnvChangeAction = "create"
+	EnvChangeUpdate EnvChangeAction = "update"
+	EnvChangeDelete EnvChangeAction = "delete"
+)
+
+// EnvChange is a single planned change to an environment variable.
+type EnvChange struct {
+	Action   EnvChangeAction
+	Key      string
+	Value    string  // new value; empty for deletes
+	Type     EnvType // type of created variables; empty uses the caller's default
+	Existing *EnvVar // nil for creates
+}
+
+// EnvSyncOptions configures SyncEnvFile.
+type EnvSyncOptions struct {
+	// Type is the type of new variables. Defaults to EnvTypePlain. Variables
+	// split off a shared one keep its type.
+	Type EnvType
+	// Delete removes variables for the target that are missing from the file.
+	Delete bool
+	// PlanOnly computes the changes without applying them.
+	PlanOnly bool
+	// Output receives the plan, with values masked, when set.
+	Output io.Writer
+}
+
+// EnvSyncPlan lists the changes needed to bring a target in line with a
+// dotenv file.
+type EnvSyncPlan struct {
+	Target  EnvTarget
+	Changes []EnvChange
+	// Incomparable lists keys in the file whose current value cannot be
+	// read, such as sensitive variables. They are left unchanged.
+	Incomparable []string
+}
+
+// String renders the plan with values masked, one change per line.
+func (p *EnvSyncPlan) String() string {
+	if len(p.Changes) == 0 && len(p.Incomparable) == 0 {
+		return fmt.Sprintf("%s: no changes\n", p.Target)
+	}
+
+	var b strings.Builder
+	for _, ch := range p.Changes {
+		switch ch.Action {
+		case EnvChangeCreate:
+			fmt.Fprintf(&b, "+ %s=%s (%s)\n", ch.Key, maskEnvValue(ch.Value), p.Target)
+		case EnvChangeUpdate:
+			fmt.Fprintf(&b, "~ %s=%s (%s)\n", ch.Key, maskEnvValue(ch.Value), p.Target)
+		case EnvChangeDelete:
+			fmt.Fprintf(&b, "- %s (%s)\n", ch.Key, p.Target)
+		}
+	}
+	for _, key := range p.Incomparable {
+		fmt.Fprintf(&b, "? %s (%s): current value cannot be read, skipped\n", key, p.Target)
+	}
+
+	return b.String()
+}
+
+// SyncEnvFile makes the environment variables of a project target match the
+// dotenv file at path. Variables shared with other targets are split so that
+// only the given target changes. The plan is returned whether or not it was
+// applied; on failure it is returned along with the error.
+func (c *Client) SyncEnvFile(ctx context.Context, projectIDOrName string, target EnvTarget, path string, opts EnvSyncOptions) (*EnvSyncPlan, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	desired, err := ParseDotenv(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	existing, err := c.ListEnvVarsWithOptions(ctx, projectIDOrName, ListEnvVarsOptions{Decrypt: true})
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &EnvSyncPlan{Target: target}
+	plan.Changes, plan.Incomparable = planEnvChanges(desired, existing, target, opts.Delete)
+
+	if opts.Output != nil {
+		if _, err := io.WriteString(opts.Output, plan.String()); err != nil {
+			return plan, err
+		}
+	}
+
+	if opts.PlanOnly {
+		return plan, nil
+	}
+
+	envType := opts.Type
+	if envType == "" {
+		envType = EnvTypePlain
+	}
+
+	scope := EnvScope{Project: projectIDOrName, Target: target}
+	for _, ch := range plan.Changes {
+		if err := c.applyEnvChange(ctx, scope, envType, ch); err != nil {
+			return plan, fmt.Errorf("failed to %s %s: %w", ch.Action, ch.Key, err)
+		}
+	}
+
+	return plan, nil
+}
+
+// planEnvChanges computes the changes that make the variables of target
+// match desired, sorted by key. Keys whose current value cannot be read are
+// returned separately, sorted, instead of being updated on every run.
+func planEnvChanges(desired map[string]string, existing []EnvVar, target EnvTarget, deleteMissing bool) ([]EnvChange, []string) {
+	current := make(map[string]*EnvVar)
+	for i := range existing {
+		ev := &existing[i]
+		if ev.GitBranch != "" || !envTargetsOverlap(ev.Target, []EnvTarget{target}) {
+			continue
+		}
+		if _, ok := current[ev.Key]; !ok {
+			current[ev.Key] = ev
+		}
+	}
+
+	var changes []EnvChange
+	var incomparable []string
+	for key, value := range desired {
+		ev, ok := current[key]
+		switch {
+		case !ok:
+			changes = append(changes, EnvChange{Action: EnvChangeCreate, Key: key, Value: value})
+		case !envValueKnown(*ev):
+			incomparable = append(incomparable, key)
+		case ev.Value != value:
+			changes = append(changes, EnvChange{Action: EnvChangeUpdate, Key: key, Value: value, Existing: ev})
+		}
+	}
+
+	if deleteMissing {
+		for key, ev := range current {
+			if _, ok := desired[key]; !ok {
+				changes = append(changes, EnvChange{Action: EnvChangeDelete, Key: key, Existing: ev})
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	sort.Strings(incomparable)
+
+	return changes, incomparable
+}
+
+// applyEnvChange applies a planned change to a single scope. A variable
+// that also applies to other targets is narrowed to those targets instead of
+// being modified or deleted, and a copy scoped to this target is created
+// with the type of the shared variable. If creating the copy fails, the
+// shared variable gets its targets back.
+//
+// envType is the type of new variables; ch.Type overrides it and the type of
+// the shared variable, and is also set on updated variables.
+func (c *Client) applyEnvChange(ctx context.Context, scope EnvScope, envType EnvType, ch EnvChange) error {
+	shared := ch.Existing != nil && len(ch.Existing.Target) > 1
+
+	switch {
+	case ch.Action == EnvChangeDelete && shared:
+		_, err := c.UpdateEnvVar(ctx, scope.Project, ch.Existing.ID, UpdateEnvVarRequest{Target: withoutEnvTarget(ch.Existing.Target, scope.Target)})
+		return err
+	case ch.Action == EnvChangeDelete:
+		return c.DeleteEnvVar(ctx, scope.Project, ch.Existing.ID)
+	case ch.Action == EnvChangeUpdate && !shared:
+		// Check the new type against the targets the variable keeps.
+		if ch.Type != "" {
+			if err := validateEnvVarFields(ch.Type, ch.Existing.Target, ch.Existing.GitBranch, true); err != nil {
+				return err
+			}
+		}
+		_, err := c.UpdateEnvVar(ctx, scope.Project, ch.Existing.ID, UpdateEnvVarRequest{Value: ch.Value, Type: ch.Type})
+		return err
+	}
+
+	if ch.Existing != nil {
+		envType = ch.Existing.Type
+	}
+	if ch.Type != "" {
+		envType = ch.Type
+	}
+	req := CreateEnvVarRequest{
+		Key:       ch.Key,
+		Value:     ch.Value,
+		Type:      envType,
+		Target:    []EnvTarget{scope.Target},
+		GitBranch: scope.GitBranch,
+	}
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	if !shared {
+		_, err := c.CreateEnvVar(ctx, scope.Project, req)
+		return err
+	}
+
+	// The API rejects two variables with the same key and target, so the
+	// shared variable has to be narrowed before the copy is created.
+	others := withoutEnvTarget(ch.Existing.Target, scope.Target)
+	if _, err := c.UpdateEnvVar(ctx, scope.Project, ch.Existing.ID, UpdateEnvVarRequest{Target: others}); err != nil {
+		return err
+	}
+	if _, err := c.CreateEnvVar(ctx, scope.Project, req); err != nil {
+		restoreCtx := context.WithoutCancel(ctx)
+		if _, restoreErr := c.UpdateEnvVar(restoreCtx, scope.Project, ch.Existing.ID, UpdateEnvVarRequest{Target: ch.Existing.Target}); restoreErr != nil {
+			return fmt.Errorf("%w (restoring targets %v of %s also failed: %v)", err, ch.Existing.Target, ch.Key, restoreErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func withoutEnvTarget(targets []EnvTarget, target EnvTarget) []EnvTarget {
+	var out []EnvTarget
+	for _, t := range targets {
+		if t != target {
+			out = append(out, t)
+		}
+	}
+
+	return out
+}
+
+// maskEnvValue hides a value for display.
+func maskEnvValue(value string) string {
+	if value == "" {
+		return `""`
+	}
+
+	return "********"
+}
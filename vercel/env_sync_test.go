@@ -0,0 +1,199 @@
+package vercel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncEnvFile_Apply(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env.production")
+	require.NoError(t, os.WriteFile(path, []byte("KEEP=same\nCHANGE=new\nSHARED=new\nADD=added\n"), 0o600))
+
+	existing := []EnvVar{
+		{ID: "env-keep", Key: "KEEP", Value: "same", Type: EnvTypePlain, Target: []EnvTarget{EnvTargetProduction}},
+		{ID: "env-change", Key: "CHANGE", Value: "old", Type: EnvTypePlain, Target: []EnvTarget{EnvTargetProduction}},
+		{ID: "env-shared", Key: "SHARED", Value: "old", Type: EnvTypePlain, Target: []EnvTarget{EnvTargetProduction, EnvTargetPreview}},
+		{ID: "env-stale", Key: "STALE", Value: "x", Type: EnvTypePlain, Target: []EnvTarget{EnvTargetProduction}},
+		{ID: "env-preview", Key: "PREVIEW_ONLY", Value: "x", Type: EnvTypePlain, Target: []EnvTarget{EnvTargetPreview}},
+	}
+
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			json.NewEncoder(w).Encode(map[string]interface{}{"env": existing})
+			return
+		}
+
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case "POST":
+			var req CreateEnvVarRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			assert.Equal(t, []EnvTarget{EnvTargetProduction}, req.Target)
+			json.NewEncoder(w).Encode(EnvVar{ID: "env-new", Key: req.Key})
+		case "PATCH":
+			var req UpdateEnvVarRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if r.URL.Path == "/v9/projects/proj-1/env/env-shared" {
+				assert.Equal(t, []EnvTarget{EnvTargetPreview}, req.Target)
+				assert.Empty(t, req.Value)
+			}
+			json.NewEncoder(w).Encode(EnvVar{ID: "env-x"})
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	var out bytes.Buffer
+	plan, err := c.SyncEnvFile(context.Background(), "proj-1", EnvTargetProduction, path, EnvSyncOptions{
+		Delete: true,
+		Output: &out,
+	})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 4)
+
+	assert.Equal(t, []string{
+		"POST /v9/projects/proj-1/env",
+		"PATCH /v9/projects/proj-1/env/env-change",
+		"PATCH /v9/projects/proj-1/env/env-shared",
+		"POST /v9/projects/proj-1/env",
+		"DELETE /v9/projects/proj-1/env/env-stale",
+	}, calls)
+	assert.Equal(t, "+ ADD=******** (production)\n~ CHANGE=******** (production)\n~ SHARED=******** (production)\n- STALE (production)\n", out.String())
+	assert.NotContains(t, out.String(), "new")
+}
+
+func TestSyncEnvFile_PlanOnly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env.preview")
+	require.NoError(t, os.WriteFile(path, []byte("A=1\n"), 0o600))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		json.NewEncoder(w).Encode(map[string]interface{}{"env": []EnvVar{}})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	plan, err := c.SyncEnvFile(context.Background(), "proj-1", EnvTargetPreview, path, EnvSyncOptions{PlanOnly: true})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1)
+	assert.Equal(t, EnvChangeCreate, plan.Changes[0].Action)
+	assert.Equal(t, "1", plan.Changes[0].Value)
+}
+
+func TestSyncEnvFile_SharedTypedVars(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env.production")
+	require.NoError(t, os.WriteFile(path, []byte("TOKEN=new\nSIGNING_KEY=new\n"), 0o600))
+
+	existing := []EnvVar{
+		{ID: "env-token", Key: "TOKEN", Value: "old", Type: EnvTypeEncrypted, Decrypted: true, Target: []EnvTarget{EnvTargetProduction, EnvTargetPreview}},
+		{ID: "env-signing", Key: "SIGNING_KEY", Type: EnvTypeSensitive, Target: []EnvTarget{EnvTargetProduction, EnvTargetPreview}},
+	}
+
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			json.NewEncoder(w).Encode(map[string]interface{}{"env": existing})
+			return
+		}
+
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case "POST":
+			var req CreateEnvVarRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			assert.Equal(t, "TOKEN", req.Key)
+			assert.Equal(t, EnvTypeEncrypted, req.Type)
+			assert.Equal(t, []EnvTarget{EnvTargetProduction}, req.Target)
+			json.NewEncoder(w).Encode(EnvVar{ID: "env-new", Key: req.Key})
+		case "PATCH":
+			var req UpdateEnvVarRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			assert.Equal(t, []EnvTarget{EnvTargetPreview}, req.Target)
+			json.NewEncoder(w).Encode(EnvVar{ID: "env-token"})
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	var out bytes.Buffer
+	plan, err := c.SyncEnvFile(context.Background(), "proj-1", EnvTargetProduction, path, EnvSyncOptions{Output: &out})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1)
+	assert.Equal(t, "TOKEN", plan.Changes[0].Key)
+	assert.Equal(t, []string{"SIGNING_KEY"}, plan.Incomparable)
+
+	assert.Equal(t, []string{
+		"PATCH /v9/projects/proj-1/env/env-token",
+		"POST /v9/projects/proj-1/env",
+	}, calls)
+	assert.Equal(t, "~ TOKEN=******** (production)\n? SIGNING_KEY (production): current value cannot be read, skipped\n", out.String())
+}
+
+func TestSyncEnvFile_RestoresTargetsOnFailedSplit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env.production")
+	require.NoError(t, os.WriteFile(path, []byte("TOKEN=new\n"), 0o600))
+
+	existing := []EnvVar{
+		{ID: "env-token", Key: "TOKEN", Value: "old", Type: EnvTypeEncrypted, Decrypted: true, Target: []EnvTarget{EnvTargetProduction, EnvTargetPreview}},
+	}
+
+	var targets [][]EnvTarget
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			json.NewEncoder(w).Encode(map[string]interface{}{"env": existing})
+		case "POST":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error":{"code":"internal","message":"boom"}}`))
+		case "PATCH":
+			var req UpdateEnvVarRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			targets = append(targets, req.Target)
+			json.NewEncoder(w).Encode(EnvVar{ID: "env-token"})
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	_, err := c.SyncEnvFile(context.Background(), "proj-1", EnvTargetProduction, path, EnvSyncOptions{})
+	require.Error(t, err)
+	assert.Equal(t, [][]EnvTarget{
+		{EnvTargetPreview},
+		{EnvTargetProduction, EnvTargetPreview},
+	}, targets)
+}
+
+func TestApplyEnvChange_ValidatesBeforeSplit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	// Sensitive variables cannot target development, so the shared variable
+	// must not be narrowed.
+	err := c.applyEnvChange(context.Background(), EnvScope{Project: "proj-1", Target: EnvTargetDevelopment}, EnvTypePlain, EnvChange{
+		Action:   EnvChangeUpdate,
+		Key:      "TOKEN",
+		Value:    "new",
+		Type:     EnvTypeSensitive,
+		Existing: &EnvVar{ID: "env-token", Key: "TOKEN", Type: EnvTypeEncrypted, Target: []EnvTarget{EnvTargetDevelopment, EnvTargetPreview}},
+	})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
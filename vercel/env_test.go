@@ -44,3 +44,115 @@ func TestUpdateEnvVar_Success(t *testing.T) {
 	assert.Equal(t, "API_KEY", envVar.Key)
 	assert.Equal(t, "new-value", envVar.Value)
 }
+
+func TestListEnvVarsWithOptions_Decrypt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v9/projects/proj-1/env", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("decrypt"))
+		assert.Equal(t, "feature-x", r.URL.Query().Get("gitBranch"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"env": []EnvVar{{ID: "env-1", Key: "API_KEY", Value: "plaintext", Type: EnvTypePlain}},
+		})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	envVars, err := c.ListEnvVarsWithOptions(context.Background(), "proj-1", ListEnvVarsOptions{Decrypt: true, GitBranch: "feature-x"})
+	require.NoError(t, err)
+	assert.Len(t, envVars, 1)
+	assert.Equal(t, "plaintext", envVars[0].Value)
+}
+
+func TestGetEnvVar_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/projects/proj-1/env/env-1", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(EnvVar{ID: "env-1", Key: "API_KEY", Value: "plaintext", Type: EnvTypePlain})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	envVar, err := c.GetEnvVar(context.Background(), "proj-1", "env-1")
+	require.NoError(t, err)
+	assert.Equal(t, "plaintext", envVar.Value)
+}
+
+func TestCreateEnvVarRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   CreateEnvVarRequest
+		field string
+	}{
+		{
+			name: "valid sensitive",
+			req:  CreateEnvVarRequest{Key: "A", Value: "v", Type: EnvTypeSensitive, Target: []EnvTarget{EnvTargetProduction, EnvTargetPreview}},
+		},
+		{
+			name: "valid branch",
+			req:  CreateEnvVarRequest{Key: "A", Value: "v", Type: EnvTypeEncrypted, Target: []EnvTarget{EnvTargetPreview}, GitBranch: "feature-x"},
+		},
+		{
+			name: "valid custom environment",
+			req:  CreateEnvVarRequest{Key: "A", Value: "v", Type: EnvTypePlain, CustomEnvironmentIDs: []string{"env_staging"}},
+		},
+		{
+			name:  "missing key",
+			req:   CreateEnvVarRequest{Value: "v", Type: EnvTypePlain, Target: []EnvTarget{EnvTargetProduction}},
+			field: "key",
+		},
+		{
+			name:  "missing target",
+			req:   CreateEnvVarRequest{Key: "A", Value: "v", Type: EnvTypePlain},
+			field: "target",
+		},
+		{
+			name:  "unknown type",
+			req:   CreateEnvVarRequest{Key: "A", Value: "v", Type: "magic", Target: []EnvTarget{EnvTargetProduction}},
+			field: "type",
+		},
+		{
+			name:  "sensitive on development",
+			req:   CreateEnvVarRequest{Key: "A", Value: "v", Type: EnvTypeSensitive, Target: []EnvTarget{EnvTargetDevelopment}},
+			field: "type",
+		},
+		{
+			name:  "branch outside preview",
+			req:   CreateEnvVarRequest{Key: "A", Value: "v", Type: EnvTypePlain, Target: []EnvTarget{EnvTargetProduction}, GitBranch: "main"},
+			field: "gitBranch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var valErr *ValidationError
+			require.ErrorAs(t, err, &valErr)
+			assert.Equal(t, tt.field, valErr.Field)
+		})
+	}
+}
+
+func TestCreateEnvVar_ValidationError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent")
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	req := CreateEnvVarRequest{Key: "A", Value: "v", Type: EnvTypeSensitive, Target: []EnvTarget{EnvTargetDevelopment}}
+	envVar, err := c.CreateEnvVar(context.Background(), "proj-1", req)
+	require.Error(t, err)
+	assert.Nil(t, envVar)
+}
@@ -0,0 +1,202 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// EnvUpsertAction describes what UpsertEnvVars did with a variable.
+type EnvUpsertAction string
+
+const (
+	EnvUpsertCreated   EnvUpsertAction = "created"
+	EnvUpsertUpdated   EnvUpsertAction = "updated"
+	EnvUpsertUnchanged EnvUpsertAction = "unchanged"
+	EnvUpsertFailed    EnvUpsertAction = "failed"
+)
+
+// EnvUpsertResult reports the outcome of upserting a single variable.
+type EnvUpsertResult struct {
+	Key    string
+	Target []EnvTarget
+	Action EnvUpsertAction
+	EnvVar *EnvVar // nil when unchanged or failed
+	Err    error   // set when Action is EnvUpsertFailed
+}
+
+// UpsertEnvVars creates or updates environment variables for a project.
+// Each request is matched against the existing variables by key and
+// overlapping targets. Changed variables are written with the API's upsert
+// mode, falling back to UpdateEnvVar when the API rejects the upsert with a
+// 400 or 409; other errors are reported as is.
+// A result is returned for every request; failures are reported per key
+// rather than aborting the remaining requests.
+func (c *Client) UpsertEnvVars(ctx context.Context, projectIDOrName string, reqs []CreateEnvVarRequest) ([]EnvUpsertResult, error) {
+	existing, err := c.ListEnvVarsWithOptions(ctx, projectIDOrName, ListEnvVarsOptions{Decrypt: true})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]EnvUpsertResult, 0, len(reqs))
+	for _, req := range reqs {
+		results = append(results, c.upsertEnvVar(ctx, projectIDOrName, req, existing))
+	}
+
+	return results, nil
+}
+
+func (c *Client) upsertEnvVar(ctx context.Context, projectIDOrName string, req CreateEnvVarRequest, existing []EnvVar) EnvUpsertResult {
+	result := EnvUpsertResult{Key: req.Key, Target: req.Target}
+
+	if err := req.Validate(); err != nil {
+		result.Action = EnvUpsertFailed
+		result.Err = err
+		return result
+	}
+
+	matches := matchEnvVars(existing, req)
+	if len(matches) > 1 {
+		result.Action = EnvUpsertFailed
+		result.Err = fmt.Errorf("%s is defined by %d variables overlapping targets %s", req.Key, len(matches), formatEnvTargets(req.Target))
+		return result
+	}
+
+	var match *EnvVar
+	if len(matches) == 1 {
+		match = &matches[0]
+		if envVarUnchanged(*match, req) {
+			result.Action = EnvUpsertUnchanged
+			return result
+		}
+	}
+
+	envVar, err := c.createEnvVarUpsert(ctx, projectIDOrName, req)
+	if match != nil && upsertRejected(err) {
+		envVar, err = c.UpdateEnvVar(ctx, projectIDOrName, match.ID, UpdateEnvVarRequest{
+			Value:     req.Value,
+			Type:      req.Type,
+			Target:    req.Target,
+			GitBranch: req.GitBranch,
+			Comment:   req.Comment,
+		})
+	}
+	if err != nil {
+		result.Action = EnvUpsertFailed
+		result.Err = err
+		return result
+	}
+
+	result.EnvVar = envVar
+	result.Action = EnvUpsertCreated
+	if match != nil {
+		result.Action = EnvUpsertUpdated
+	}
+
+	return result
+}
+
+// createEnvVarUpsert creates an environment variable with upsert=true, which
+// overwrites an existing variable with the same key and target.
+func (c *Client) createEnvVarUpsert(ctx context.Context, projectIDOrName string, req CreateEnvVarRequest) (*EnvVar, error) {
+	var resp struct {
+		Created json.RawMessage `json:"created"`
+		Failed  []struct {
+			Error struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"error"`
+		} `json:"failed"`
+	}
+
+	query := map[string]string{"upsert": "true"}
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/v10/projects/%s/env", projectIDOrName), query, req, &resp); err != nil {
+		return nil, err
+	}
+
+	// Per-variable failures come back in a successful response; report them
+	// as the rejection they are.
+	if len(resp.Failed) > 0 {
+		return nil, &APIError{
+			StatusCode: http.StatusBadRequest,
+			Code:       resp.Failed[0].Error.Code,
+			Message:    resp.Failed[0].Error.Message,
+		}
+	}
+
+	// created is an object for a single variable and an array for several.
+	var envVar EnvVar
+	if err := json.Unmarshal(resp.Created, &envVar); err != nil {
+		var created []EnvVar
+		if err := json.Unmarshal(resp.Created, &created); err != nil || len(created) == 0 {
+			return nil, fmt.Errorf("failed to unmarshal created environment variable: %s", resp.Created)
+		}
+		envVar = created[0]
+	}
+
+	return &envVar, nil
+}
+
+// upsertRejected reports whether err is the API refusing an upsert request,
+// as opposed to a server, network or context error.
+func upsertRejected(err error) bool {
+	apiErr, ok := IsAPIError(err)
+	return ok && (apiErr.StatusCode == http.StatusBadRequest || apiErr.StatusCode == http.StatusConflict)
+}
+
+// matchEnvVars returns the existing variables with the same key and git
+// branch as req and at least one target in common.
+func matchEnvVars(existing []EnvVar, req CreateEnvVarRequest) []EnvVar {
+	var matches []EnvVar
+	for _, ev := range existing {
+		if ev.Key != req.Key || ev.GitBranch != req.GitBranch {
+			continue
+		}
+		if envTargetsOverlap(ev.Target, req.Target) {
+			matches = append(matches, ev)
+		}
+	}
+
+	return matches
+}
+
+// envVarUnchanged reports whether an existing variable already holds the
+// requested value, type, comment and targets. Values that could not be
+// decrypted never compare equal.
+func envVarUnchanged(ev EnvVar, req CreateEnvVarRequest) bool {
+	return ev.Value != "" &&
+		ev.Value == req.Value &&
+		ev.Comment == req.Comment &&
+		ev.Type == req.Type &&
+		envTargetsEqual(ev.Target, req.Target)
+}
+
+func envTargetsOverlap(a, b []EnvTarget) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func envTargetsEqual(a, b []EnvTarget) bool {
+	return formatEnvTargets(a) == formatEnvTargets(b)
+}
+
+// formatEnvTargets returns a stable, comma-separated form of targets.
+func formatEnvTargets(targets []EnvTarget) string {
+	names := make([]string, len(targets))
+	for i, t := range targets {
+		names[i] = string(t)
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ",")
+}
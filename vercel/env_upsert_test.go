@@ -0,0 +1,124 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsertEnvVars_Report(t *testing.T) {
+	prod := []EnvTarget{EnvTargetProduction}
+	existing := []EnvVar{
+		{ID: "env-same", Key: "SAME", Value: "v1", Type: EnvTypePlain, Target: prod},
+		{ID: "env-changed", Key: "CHANGED", Value: "old", Type: EnvTypePlain, Target: prod},
+		{ID: "env-legacy", Key: "LEGACY", Value: "old", Type: EnvTypePlain, Target: prod},
+		{ID: "env-split-1", Key: "SPLIT", Value: "a", Type: EnvTypePlain, Target: prod},
+		{ID: "env-split-2", Key: "SPLIT", Value: "b", Type: EnvTypePlain, Target: []EnvTarget{EnvTargetPreview}},
+	}
+
+	var updated []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/v9/projects/proj-1/env":
+			json.NewEncoder(w).Encode(map[string]interface{}{"env": existing})
+		case r.Method == "POST" && r.URL.Path == "/v10/projects/proj-1/env":
+			assert.Equal(t, "true", r.URL.Query().Get("upsert"))
+
+			var req CreateEnvVarRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Key == "LEGACY" {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"error": map[string]string{"code": "ENV_ALREADY_EXISTS", "message": "exists"},
+				})
+				return
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"created": EnvVar{ID: "env-" + req.Key, Key: req.Key, Type: req.Type, Target: req.Target},
+				"failed":  []interface{}{},
+			})
+		case r.Method == "PATCH":
+			updated = append(updated, r.URL.Path)
+			json.NewEncoder(w).Encode(EnvVar{ID: "env-legacy", Key: "LEGACY"})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	reqs := []CreateEnvVarRequest{
+		{Key: "SAME", Value: "v1", Type: EnvTypePlain, Target: prod},
+		{Key: "CHANGED", Value: "new", Type: EnvTypePlain, Target: prod},
+		{Key: "NEW", Value: "x", Type: EnvTypePlain, Target: prod},
+		{Key: "LEGACY", Value: "new", Type: EnvTypePlain, Target: prod},
+		{Key: "SPLIT", Value: "c", Type: EnvTypePlain, Target: []EnvTarget{EnvTargetProduction, EnvTargetPreview}},
+	}
+
+	results, err := c.UpsertEnvVars(context.Background(), "proj-1", reqs)
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	assert.Equal(t, EnvUpsertUnchanged, results[0].Action)
+	assert.Equal(t, EnvUpsertUpdated, results[1].Action)
+	assert.Equal(t, EnvUpsertCreated, results[2].Action)
+	assert.Equal(t, "env-NEW", results[2].EnvVar.ID)
+	assert.Equal(t, EnvUpsertUpdated, results[3].Action)
+	assert.Equal(t, []string{"/v9/projects/proj-1/env/env-legacy"}, updated)
+	assert.Equal(t, EnvUpsertFailed, results[4].Action)
+	assert.Error(t, results[4].Err)
+}
+
+func TestUpsertEnvVars_NoFallbackOnServerError(t *testing.T) {
+	prod := []EnvTarget{EnvTargetProduction}
+	existing := []EnvVar{
+		{ID: "env-changed", Key: "CHANGED", Value: "old", Type: EnvTypePlain, Target: prod},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET":
+			json.NewEncoder(w).Encode(map[string]interface{}{"env": existing})
+		case r.Method == "POST":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error":{"code":"internal_error","message":"boom"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	results, err := c.UpsertEnvVars(context.Background(), "proj-1", []CreateEnvVarRequest{
+		{Key: "CHANGED", Value: "new", Type: EnvTypePlain, Target: prod},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, EnvUpsertFailed, results[0].Action)
+
+	apiErr, ok := IsAPIError(results[0].Err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+}
+
+func TestUpsertEnvVars_ListError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	results, err := c.UpsertEnvVars(context.Background(), "proj-1", []CreateEnvVarRequest{{Key: "A"}})
+	require.Error(t, err)
+	assert.Nil(t, results)
+}
@@ -1,6 +1,10 @@
 package vercel
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
 
 // APIError represents an error response from the Vercel API.
 type APIError struct {
@@ -10,6 +14,31 @@ type APIError struct {
 	RawBody    []byte
 }
 
+// newAPIError builds an APIError from a non-2xx response.
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		RawBody:    body,
+		Message:    http.StatusText(statusCode),
+	}
+
+	// Try to unmarshal error response
+	var errorResp struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &errorResp); err == nil {
+		apiErr.Code = errorResp.Error.Code
+		if errorResp.Error.Message != "" {
+			apiErr.Message = errorResp.Error.Message
+		}
+	}
+
+	return apiErr
+}
+
 // Error implements the error interface.
 func (e *APIError) Error() string {
 	if e.Code != "" {
@@ -27,3 +56,24 @@ func IsAPIError(err error) (*APIError, bool) {
 	return apiErr, ok
 }
 
+// BuildOutputError reports an invalid file in a Build Output API directory.
+type BuildOutputError struct {
+	Path    string
+	Message string
+}
+
+// Error implements the error interface.
+func (e *BuildOutputError) Error() string {
+	return fmt.Sprintf("vercel: invalid build output %s: %s", e.Path, e.Message)
+}
+
+// ValidationError reports a request that was rejected before being sent.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("vercel: invalid %s: %s", e.Field, e.Message)
+}
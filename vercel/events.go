@@ -0,0 +1,228 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultEventsPageSize is the page size used when ListEventsOptions.Limit
+// is not set.
+const defaultEventsPageSize = 100
+
+// DefaultEventsPollInterval is the polling interval FollowEvents uses when
+// none is given.
+const DefaultEventsPollInterval = 10 * time.Second
+
+// DecodePayload decodes the event payload into v.
+func (e Event) DecodePayload(v interface{}) error {
+	if len(e.Payload) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(e.Payload, v)
+}
+
+// TypedPayload decodes the payload into *DeploymentEventPayload,
+// *EnvVarEventPayload or *DomainEventPayload based on the event type, and
+// into a map for other events.
+func (e Event) TypedPayload() (interface{}, error) {
+	var v interface{}
+	switch {
+	case strings.Contains(e.Type, "env-variable"):
+		v = &EnvVarEventPayload{}
+	case strings.HasPrefix(e.Type, "deployment"):
+		v = &DeploymentEventPayload{}
+	case strings.HasPrefix(e.Type, "domain"):
+		v = &DomainEventPayload{}
+	default:
+		m := make(map[string]interface{})
+		v = &m
+	}
+
+	if err := e.DecodePayload(v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// ListEvents lists one page of events of the authenticated user or team,
+// newest first.
+func (c *Client) ListEvents(ctx context.Context, opts ListEventsOptions) ([]Event, error) {
+	query := map[string]string{
+		"withPayload": "true",
+		"types":       strings.Join(opts.Types, ","),
+		"userId":      opts.UserID,
+		"principalId": opts.PrincipalID,
+		"projectIds":  strings.Join(opts.ProjectIDs, ","),
+	}
+	if opts.Limit > 0 {
+		query["limit"] = strconv.Itoa(opts.Limit)
+	}
+	if !opts.Since.IsZero() {
+		query["since"] = strconv.FormatInt(opts.Since.UnixMilli(), 10)
+	}
+	if !opts.Until.IsZero() {
+		query["until"] = strconv.FormatInt(opts.Until.UnixMilli(), 10)
+	}
+
+	var resp struct {
+		Events []Event `json:"events"`
+	}
+	if err := c.doRequest(ctx, "GET", "/v3/events", query, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Events, nil
+}
+
+// EventIterator iterates over events newest first, fetching older pages as
+// needed until a page comes back empty. Events repeated across page
+// boundaries are skipped.
+type EventIterator struct {
+	c    *Client
+	ctx  context.Context
+	opts ListEventsOptions
+
+	page    []Event
+	current Event
+	seen    map[string]int64 // events at the page boundary, by ID
+	done    bool
+	err     error
+}
+
+// IterateEvents returns an iterator over the events matching opts, from
+// opts.Until (or now) back to opts.Since.
+func (c *Client) IterateEvents(ctx context.Context, opts ListEventsOptions) *EventIterator {
+	if opts.Limit <= 0 {
+		opts.Limit = defaultEventsPageSize
+	}
+
+	return &EventIterator{c: c, ctx: ctx, opts: opts, seen: make(map[string]int64)}
+}
+
+// Next advances to the next event, reporting false when there are no more
+// events or an error occurred.
+func (it *EventIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+
+		events, err := it.c.ListEvents(it.ctx, it.opts)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		// The API may return fewer events than requested before the end of
+		// the feed, so only an empty page ends it.
+		if len(events) == 0 {
+			it.done = true
+			return false
+		}
+
+		// The next page ends at the oldest event of this one. Events sharing
+		// that timestamp may be returned again, so remember them.
+		oldest := events[len(events)-1].CreatedAt
+		for _, e := range events {
+			if _, ok := it.seen[e.ID]; ok {
+				continue
+			}
+			if e.CreatedAt == oldest {
+				it.seen[e.ID] = e.CreatedAt
+			}
+			it.page = append(it.page, e)
+		}
+		for id, createdAt := range it.seen {
+			if createdAt > oldest {
+				delete(it.seen, id)
+			}
+		}
+		if len(it.page) == 0 && oldest == it.opts.Until.UnixMilli() {
+			// A full page of already seen events in one millisecond; step
+			// past it.
+			oldest--
+		}
+
+		it.opts.Until = time.UnixMilli(oldest)
+	}
+
+	it.current, it.page = it.page[0], it.page[1:]
+
+	return true
+}
+
+// Event returns the current event.
+func (it *EventIterator) Event() Event {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *EventIterator) Err() error {
+	return it.err
+}
+
+// FollowEvents polls for new events every interval and calls fn for each one
+// in chronological order, without duplicates. It starts at opts.Since, or at
+// the time of the call when opts.Since is zero; opts.Until is ignored.
+// FollowEvents runs until ctx is done or fn returns an error, and returns
+// that error. An interval <= 0 means DefaultEventsPollInterval.
+func (c *Client) FollowEvents(ctx context.Context, opts ListEventsOptions, interval time.Duration, fn func(Event) error) error {
+	if interval <= 0 {
+		interval = DefaultEventsPollInterval
+	}
+
+	cursor := opts.Since
+	if cursor.IsZero() {
+		cursor = time.Now()
+	}
+	opts.Until = time.Time{}
+
+	// delivered holds the IDs of delivered events created at the cursor.
+	delivered := make(map[string]bool)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// Overlap the previous poll by a millisecond so events created in
+		// the same millisecond as the cursor are not missed.
+		pollOpts := opts
+		pollOpts.Since = cursor.Add(-time.Millisecond)
+
+		var batch []Event
+		it := c.IterateEvents(ctx, pollOpts)
+		for it.Next() {
+			e := it.Event()
+			if e.CreatedAt < cursor.UnixMilli() || (e.CreatedAt == cursor.UnixMilli() && delivered[e.ID]) {
+				continue
+			}
+			batch = append(batch, e)
+		}
+		if err := it.Err(); err != nil {
+			return err
+		}
+
+		for i := len(batch) - 1; i >= 0; i-- {
+			e := batch[i]
+			if err := fn(e); err != nil {
+				return err
+			}
+
+			if e.CreatedAt > cursor.UnixMilli() {
+				cursor = time.UnixMilli(e.CreatedAt)
+				delivered = make(map[string]bool)
+			}
+			delivered[e.ID] = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
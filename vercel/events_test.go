@@ -0,0 +1,175 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// eventsServer serves events newest first, honouring since, until (both
+// inclusive) and limit.
+func eventsServer(t *testing.T, events func() []Event) *httptest.Server {
+	return httptest.NewServer(eventsHandler(t, events, 0))
+}
+
+// eventsHandler serves events like eventsServer, returning at most maxPage
+// events per page when maxPage is positive.
+func eventsHandler(t *testing.T, events func() []Event, maxPage int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/events", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("withPayload"))
+
+		q := r.URL.Query()
+		since, _ := strconv.ParseInt(q.Get("since"), 10, 64)
+		until, _ := strconv.ParseInt(q.Get("until"), 10, 64)
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		if maxPage > 0 && (limit <= 0 || limit > maxPage) {
+			limit = maxPage
+		}
+
+		var page []Event
+		for _, e := range events() {
+			if (since > 0 && e.CreatedAt < since) || (until > 0 && e.CreatedAt > until) {
+				continue
+			}
+			if limit > 0 && len(page) == limit {
+				break
+			}
+			page = append(page, e)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{"events": page})
+	}
+}
+
+func TestListEvents_Filters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "deployment,project-env-variable", q.Get("types"))
+		assert.Equal(t, "user-1", q.Get("userId"))
+		assert.Equal(t, "1700000000000", q.Get("since"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"events":[{"id":"evt-1","type":"project-env-variable","createdAt":1700000000001,"payload":{"key":"API_KEY","target":["production"],"projectName":"web"}}]}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	events, err := c.ListEvents(context.Background(), ListEventsOptions{
+		Types:  []string{"deployment", "project-env-variable"},
+		UserID: "user-1",
+		Since:  time.UnixMilli(1700000000000),
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	payload, err := events[0].TypedPayload()
+	require.NoError(t, err)
+	require.IsType(t, &EnvVarEventPayload{}, payload)
+	assert.Equal(t, "API_KEY", payload.(*EnvVarEventPayload).Key)
+	assert.Equal(t, []EnvTarget{EnvTargetProduction}, payload.(*EnvVarEventPayload).Target)
+}
+
+func TestIterateEvents_Pages(t *testing.T) {
+	all := []Event{
+		{ID: "evt-5", CreatedAt: 500},
+		{ID: "evt-4", CreatedAt: 400},
+		{ID: "evt-3", CreatedAt: 300},
+		{ID: "evt-2", CreatedAt: 300},
+		{ID: "evt-1", CreatedAt: 100},
+	}
+	server := eventsServer(t, func() []Event { return all })
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	it := c.IterateEvents(context.Background(), ListEventsOptions{Limit: 2})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Event().ID)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"evt-5", "evt-4", "evt-3", "evt-2", "evt-1"}, ids)
+}
+
+func TestIterateEvents_ShortPages(t *testing.T) {
+	all := []Event{
+		{ID: "evt-5", CreatedAt: 500},
+		{ID: "evt-4", CreatedAt: 400},
+		{ID: "evt-3", CreatedAt: 300},
+		{ID: "evt-2", CreatedAt: 200},
+		{ID: "evt-1", CreatedAt: 100},
+	}
+	// The server caps pages at 2 events, below the requested limit.
+	server := httptest.NewServer(eventsHandler(t, func() []Event { return all }, 2))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	it := c.IterateEvents(context.Background(), ListEventsOptions{Limit: 100})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Event().ID)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"evt-5", "evt-4", "evt-3", "evt-2", "evt-1"}, ids)
+}
+
+func TestFollowEvents_DefaultInterval(t *testing.T) {
+	server := eventsServer(t, func() []Event { return []Event{{ID: "evt-1", CreatedAt: 1000}} })
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	stop := errors.New("stop")
+	err := c.FollowEvents(context.Background(), ListEventsOptions{Since: time.UnixMilli(1000)}, 0, func(e Event) error {
+		return stop
+	})
+	assert.ErrorIs(t, err, stop)
+}
+
+func TestFollowEvents(t *testing.T) {
+	var mu sync.Mutex
+	all := []Event{
+		{ID: "evt-1", CreatedAt: 1000},
+	}
+	server := eventsServer(t, func() []Event {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]Event(nil), all...)
+	})
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	var got []string
+	stop := errors.New("stop")
+	err := c.FollowEvents(context.Background(), ListEventsOptions{Since: time.UnixMilli(1000)}, time.Millisecond, func(e Event) error {
+		got = append(got, e.ID)
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch e.ID {
+		case "evt-1":
+			// A late event in the same millisecond as the cursor, and newer ones.
+			all = append([]Event{{ID: "evt-3", CreatedAt: 2000}, {ID: "evt-2", CreatedAt: 1000}}, all...)
+		case "evt-3":
+			return stop
+		}
+		return nil
+	})
+
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, []string{"evt-1", "evt-2", "evt-3"}, got)
+}
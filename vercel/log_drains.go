@@ -0,0 +1,76 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// logDrainSources is the set of valid log drain sources.
+var logDrainSources = map[LogDrainSource]bool{
+	LogDrainSourceStatic:   true,
+	LogDrainSourceLambda:   true,
+	LogDrainSourceEdge:     true,
+	LogDrainSourceBuild:    true,
+	LogDrainSourceExternal: true,
+}
+
+// ListLogDrains lists the log drains of the authenticated user or team. If
+// projectID is not empty, only log drains for that project are returned.
+func (c *Client) ListLogDrains(ctx context.Context, projectID string) ([]LogDrain, error) {
+	var drains []LogDrain
+	if err := c.doRequest(ctx, "GET", "/v2/integrations/log-drains", map[string]string{"projectId": projectID}, nil, &drains); err != nil {
+		return nil, err
+	}
+
+	return drains, nil
+}
+
+// CreateLogDrain creates a log drain.
+func (c *Client) CreateLogDrain(ctx context.Context, req CreateLogDrainRequest) (*LogDrain, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var drain LogDrain
+	if err := c.doRequest(ctx, "POST", "/v2/integrations/log-drains", nil, req, &drain); err != nil {
+		return nil, err
+	}
+
+	return &drain, nil
+}
+
+// DeleteLogDrain deletes a log drain by ID.
+func (c *Client) DeleteLogDrain(ctx context.Context, drainID string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v1/integrations/log-drains/%s", drainID), nil, nil, nil)
+}
+
+// Validate checks the name, URL, delivery format and sources of the request.
+// Syslog drains take a syslog+tls:// URL, all others an https URL.
+func (r CreateLogDrainRequest) Validate() error {
+	if r.Name == "" {
+		return &ValidationError{Field: "name", Message: "is required"}
+	}
+
+	scheme := "https"
+	switch r.DeliveryFormat {
+	case "", LogDrainFormatJSON, LogDrainFormatNDJSON:
+	case LogDrainFormatSyslog:
+		scheme = "syslog+tls"
+	default:
+		return &ValidationError{Field: "deliveryFormat", Message: fmt.Sprintf("unknown format %q", r.DeliveryFormat)}
+	}
+
+	u, err := url.Parse(r.URL)
+	if err != nil || u.Scheme != scheme || u.Host == "" {
+		return &ValidationError{Field: "url", Message: fmt.Sprintf("must be an absolute %s URL", scheme)}
+	}
+
+	for _, s := range r.Sources {
+		if !logDrainSources[s] {
+			return &ValidationError{Field: "sources", Message: fmt.Sprintf("unknown source %q", s)}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateLogDrain_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/integrations/log-drains", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body CreateLogDrainRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "pipeline", body.Name)
+		assert.Equal(t, LogDrainFormatNDJSON, body.DeliveryFormat)
+		assert.Equal(t, []LogDrainSource{LogDrainSourceLambda, LogDrainSourceEdge}, body.Sources)
+		assert.Equal(t, []string{"prj-1"}, body.ProjectIDs)
+		assert.Equal(t, "s3cret", body.Secret)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id":"ld-1","name":"pipeline","url":"https://logs.example.com","deliveryFormat":"ndjson","sources":["lambda","edge"],"projectIds":["prj-1"]}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	drain, err := c.CreateLogDrain(context.Background(), CreateLogDrainRequest{
+		Name:           "pipeline",
+		URL:            "https://logs.example.com",
+		DeliveryFormat: LogDrainFormatNDJSON,
+		Sources:        []LogDrainSource{LogDrainSourceLambda, LogDrainSourceEdge},
+		ProjectIDs:     []string{"prj-1"},
+		Secret:         "s3cret",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ld-1", drain.ID)
+}
+
+func TestCreateLogDrain_Validation(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   CreateLogDrainRequest
+		field string
+	}{
+		{"missing name", CreateLogDrainRequest{URL: "https://logs.example.com"}, "name"},
+		{"http url", CreateLogDrainRequest{Name: "d", URL: "http://logs.example.com"}, "url"},
+		{"syslog with https url", CreateLogDrainRequest{Name: "d", URL: "https://logs.example.com", DeliveryFormat: LogDrainFormatSyslog}, "url"},
+		{"unknown format", CreateLogDrainRequest{Name: "d", URL: "https://logs.example.com", DeliveryFormat: "xml"}, "deliveryFormat"},
+		{"unknown source", CreateLogDrainRequest{Name: "d", URL: "https://logs.example.com", Sources: []LogDrainSource{"cron"}}, "sources"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var validationErr *ValidationError
+			require.ErrorAs(t, tt.req.Validate(), &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+
+	assert.NoError(t, CreateLogDrainRequest{Name: "d", URL: "syslog+tls://logs.example.com:6514", DeliveryFormat: LogDrainFormatSyslog}.Validate())
+}
+
+func TestListLogDrains_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/integrations/log-drains", r.URL.Path)
+		assert.Equal(t, "prj-1", r.URL.Query().Get("projectId"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"id":"ld-1","name":"pipeline","url":"https://logs.example.com","deliveryFormat":"json"}]`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	drains, err := c.ListLogDrains(context.Background(), "prj-1")
+	require.NoError(t, err)
+	require.Len(t, drains, 1)
+	assert.Equal(t, LogDrainFormatJSON, drains[0].DeliveryFormat)
+}
+
+func TestDeleteLogDrain_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/integrations/log-drains/ld-1", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	require.NoError(t, c.DeleteLogDrain(context.Background(), "ld-1"))
+}
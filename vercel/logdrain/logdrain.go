@@ -0,0 +1,132 @@
+// Package logdrain receives Vercel log drain deliveries. Handler verifies the
+// signature of each batch, answers the drain verification handshake and
+// passes the decoded entries to a callback.
+package logdrain
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/OPTIC7409/vercel-wrapper/vercel"
+	"github.com/OPTIC7409/vercel-wrapper/vercel/webhook"
+)
+
+// VerifyHeader is the header Vercel expects in the response to prove
+// ownership of the drain URL.
+const VerifyHeader = "x-vercel-verify"
+
+// MaxBodySize is the largest batch Handler accepts.
+const MaxBodySize = 10 << 20
+
+// Handler is an http.Handler for log drain deliveries.
+//
+// Every response carries the verification token in VerifyHeader. GET and
+// HEAD requests, and POST requests with an empty body, are treated as the
+// verification handshake and answered with 200. Batches are verified with
+// the drain secret and decoded from NDJSON or a JSON array. Handler responds
+// with 403 for a missing or invalid signature, 400 for a malformed batch, 500
+// when the callback returns an error and 200 otherwise.
+type Handler struct {
+	secret      string
+	verifyToken string
+	fn          func(context.Context, []vercel.LogDrainEntry) error
+}
+
+// NewHandler returns a Handler verifying batches with the drain secret,
+// answering the handshake with verifyToken and passing the entries of each
+// batch to fn.
+func NewHandler(secret, verifyToken string, fn func(context.Context, []vercel.LogDrainEntry) error) *Handler {
+	return &Handler{secret: secret, verifyToken: verifyToken, fn: fn}
+}
+
+// ServeHTTP answers the verification handshake or verifies, decodes and
+// delivers a batch of log entries.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.verifyToken != "" {
+		w.Header().Set(VerifyHeader, h.verifyToken)
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+		return
+	case http.MethodPost:
+	default:
+		w.Header().Set("Allow", "GET, HEAD, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxBodySize+1))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	if len(body) > MaxBodySize {
+		http.Error(w, "body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if !webhook.VerifySignature(h.secret, body, r.Header.Get(webhook.SignatureHeader)) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+
+	entries, err := DecodeEntries(body)
+	if err != nil {
+		http.Error(w, "invalid batch", http.StatusBadRequest)
+		return
+	}
+
+	if len(entries) > 0 {
+		if err := h.fn(r.Context(), entries); err != nil {
+			http.Error(w, "failed to handle batch", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// DecodeEntries decodes a batch of log entries, either a JSON array or
+// newline delimited JSON objects. Blank lines are skipped.
+func DecodeEntries(body []byte) ([]vercel.LogDrainEntry, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var entries []vercel.LogDrainEntry
+		if err := json.Unmarshal(trimmed, &entries); err != nil {
+			return nil, fmt.Errorf("logdrain: failed to decode batch: %w", err)
+		}
+		return entries, nil
+	}
+
+	var entries []vercel.LogDrainEntry
+	scanner := bufio.NewScanner(bytes.NewReader(trimmed))
+	scanner.Buffer(make([]byte, 64*1024), MaxBodySize)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var entry vercel.LogDrainEntry
+		if err := json.Unmarshal(text, &entry); err != nil {
+			return nil, fmt.Errorf("logdrain: failed to decode line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("logdrain: failed to read batch: %w", err)
+	}
+
+	return entries, nil
+}
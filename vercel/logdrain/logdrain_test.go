@@ -0,0 +1,92 @@
+package logdrain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/OPTIC7409/vercel-wrapper/vercel"
+	"github.com/OPTIC7409/vercel-wrapper/vercel/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "drain-secret"
+
+func deliver(h http.Handler, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/logs", strings.NewReader(body))
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign(testSecret, []byte(body)))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_Handshake(t *testing.T) {
+	h := NewHandler(testSecret, "verify-token", func(ctx context.Context, entries []vercel.LogDrainEntry) error {
+		t.Error("callback called during handshake")
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/logs", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "verify-token", rec.Header().Get(VerifyHeader))
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/logs", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "verify-token", rec.Header().Get(VerifyHeader))
+}
+
+func TestHandler_DeliversNDJSON(t *testing.T) {
+	var got []vercel.LogDrainEntry
+	h := NewHandler(testSecret, "", func(ctx context.Context, entries []vercel.LogDrainEntry) error {
+		got = append(got, entries...)
+		return nil
+	})
+
+	body := `{"id":"log-1","message":"GET /","timestamp":1700000000000,"source":"lambda","projectId":"prj-1","deploymentId":"dpl-1","proxy":{"method":"GET","host":"example.com","path":"/","statusCode":200}}
+
+{"id":"log-2","message":"build done","timestamp":1700000000001,"source":"build","projectId":"prj-1","deploymentId":"dpl-1"}
+`
+	rec := deliver(h, body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, got, 2)
+	assert.Equal(t, vercel.LogDrainSourceLambda, got[0].Source)
+	assert.Equal(t, 200, got[0].Proxy.StatusCode)
+	assert.Equal(t, "build done", got[1].Message)
+}
+
+func TestHandler_DeliversJSONArray(t *testing.T) {
+	var got []vercel.LogDrainEntry
+	h := NewHandler(testSecret, "", func(ctx context.Context, entries []vercel.LogDrainEntry) error {
+		got = entries
+		return nil
+	})
+
+	rec := deliver(h, `[{"id":"log-1","source":"edge"},{"id":"log-2","source":"static"}]`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, got, 2)
+	assert.Equal(t, vercel.LogDrainSourceStatic, got[1].Source)
+}
+
+func TestHandler_Rejects(t *testing.T) {
+	h := NewHandler(testSecret, "", func(ctx context.Context, entries []vercel.LogDrainEntry) error {
+		return errors.New("boom")
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/logs", strings.NewReader(`{"id":"log-1"}`))
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign("wrong-secret", []byte(`{"id":"log-1"}`)))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = deliver(h, "{\"id\":\"log-1\"}\nnot json\n")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = deliver(h, `{"id":"log-1"}`)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
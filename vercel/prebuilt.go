@@ -0,0 +1,343 @@
+package vercel
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// BuildOutputDir is the location of a Build Output API directory relative
+// to the project root.
+const BuildOutputDir = ".vercel/output"
+
+// Unix file type bits used for the mode of deployment files.
+const (
+	modeRegular uint32 = 0100000
+	modeSymlink uint32 = 0120000
+)
+
+var routeHandles = map[string]bool{
+	"filesystem": true,
+	"hit":        true,
+	"miss":       true,
+	"rewrite":    true,
+	"error":      true,
+	"resource":   true,
+}
+
+// BuildOutputConfig represents the config.json file of a Build Output API v3 directory.
+type BuildOutputConfig struct {
+	Version   int                            `json:"version"`
+	Routes    []map[string]interface{}       `json:"routes,omitempty"`
+	Images    map[string]interface{}         `json:"images,omitempty"`
+	Wildcard  []map[string]interface{}       `json:"wildcard,omitempty"`
+	Overrides map[string]BuildOutputOverride `json:"overrides,omitempty"`
+	Cache     []string                       `json:"cache,omitempty"`
+	Crons     []BuildOutputCron              `json:"crons,omitempty"`
+}
+
+// BuildOutputOverride overrides the path or content type of a static file.
+type BuildOutputOverride struct {
+	Path        string `json:"path,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+}
+
+// BuildOutputCron represents a cron job declared in config.json.
+type BuildOutputCron struct {
+	Path     string `json:"path"`
+	Schedule string `json:"schedule"`
+}
+
+// BuildOutputFunctionConfig represents the .vc-config.json file of a function.
+type BuildOutputFunctionConfig struct {
+	Runtime                   string            `json:"runtime"`
+	Handler                   string            `json:"handler,omitempty"`
+	Entrypoint                string            `json:"entrypoint,omitempty"`
+	Memory                    int               `json:"memory,omitempty"`
+	MaxDuration               int               `json:"maxDuration,omitempty"`
+	Environment               map[string]string `json:"environment,omitempty"`
+	Regions                   []string          `json:"regions,omitempty"`
+	LauncherType              string            `json:"launcherType,omitempty"`
+	ShouldAddHelpers          bool              `json:"shouldAddHelpers,omitempty"`
+	SupportsResponseStreaming bool              `json:"supportsResponseStreaming,omitempty"`
+}
+
+// BuildOutputFile represents a file found in a Build Output API directory.
+type BuildOutputFile struct {
+	Path string // slash-separated, relative to the output directory
+	SHA  string
+	Size int64
+	Mode uint32
+}
+
+// BuildOutput represents a validated Build Output API v3 directory.
+type BuildOutput struct {
+	Dir       string
+	Config    BuildOutputConfig
+	Functions map[string]BuildOutputFunctionConfig // keyed by the .func path
+	Files     []BuildOutputFile
+}
+
+// ReadBuildOutput reads and validates a Build Output API v3 directory such
+// as .vercel/output. Validation failures are returned as a *BuildOutputError
+// naming the offending file.
+func ReadBuildOutput(dir string) (*BuildOutput, error) {
+	out := &BuildOutput{
+		Dir:       dir,
+		Functions: make(map[string]BuildOutputFunctionConfig),
+	}
+
+	if err := readBuildOutputConfig(filepath.Join(dir, "config.json"), &out.Config); err != nil {
+		return nil, err
+	}
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if d.IsDir() || d.Type()&fs.ModeSymlink != 0 {
+			if rel != "." && strings.HasPrefix(rel, "functions/") && strings.HasSuffix(rel, ".func") {
+				cfg, err := readFunctionConfig(p)
+				if err != nil {
+					return err
+				}
+				out.Functions[rel] = *cfg
+			}
+			if d.IsDir() {
+				return nil
+			}
+		}
+
+		file, err := readBuildOutputFile(p, d)
+		if err != nil {
+			return err
+		}
+		file.Path = rel
+		out.Files = append(out.Files, *file)
+
+		if strings.HasPrefix(rel, "functions/") && strings.HasSuffix(rel, ".prerender-config.json") {
+			return validatePrerenderConfig(p)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	static := make(map[string]bool)
+	for _, f := range out.Files {
+		if strings.HasPrefix(f.Path, "static/") {
+			static[strings.TrimPrefix(f.Path, "static/")] = true
+		}
+	}
+	for name := range out.Config.Overrides {
+		if !static[name] {
+			return nil, &BuildOutputError{
+				Path:    filepath.Join(dir, "config.json"),
+				Message: fmt.Sprintf("override for %q does not match a file in static/", name),
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// DeployPrebuilt uploads a Build Output API v3 directory and creates a
+// prebuilt deployment from it, like `vercel deploy --prebuilt`. The Files
+// and Prebuilt fields of req are set from the directory contents.
+func (c *Client) DeployPrebuilt(ctx context.Context, dir string, req CreateDeploymentRequest) (*Deployment, error) {
+	out, err := ReadBuildOutput(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	uploaded := make(map[string]bool)
+	files := make([]DeploymentFile, 0, len(out.Files))
+	for _, f := range out.Files {
+		if !uploaded[f.SHA] {
+			data, err := readBuildOutputData(filepath.Join(dir, filepath.FromSlash(f.Path)), f.Mode)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := c.UploadDeploymentFile(ctx, data); err != nil {
+				return nil, fmt.Errorf("failed to upload %s: %w", f.Path, err)
+			}
+			uploaded[f.SHA] = true
+		}
+
+		files = append(files, DeploymentFile{
+			File: path.Join(BuildOutputDir, f.Path),
+			SHA:  f.SHA,
+			Size: f.Size,
+			Mode: f.Mode,
+		})
+	}
+
+	req.Files = files
+	req.Prebuilt = true
+
+	return c.CreateDeployment(ctx, req)
+}
+
+// readBuildOutputConfig reads and validates config.json.
+func readBuildOutputConfig(p string, cfg *BuildOutputConfig) error {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return &BuildOutputError{Path: p, Message: "file not found"}
+		}
+		return err
+	}
+
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return &BuildOutputError{Path: p, Message: fmt.Sprintf("invalid JSON: %v", err)}
+	}
+
+	if cfg.Version != 3 {
+		return &BuildOutputError{Path: p, Message: fmt.Sprintf("unsupported version %d, expected 3", cfg.Version)}
+	}
+
+	for i, route := range cfg.Routes {
+		if handle, ok := route["handle"]; ok {
+			name, _ := handle.(string)
+			if !routeHandles[name] {
+				return &BuildOutputError{Path: p, Message: fmt.Sprintf("routes[%d]: unknown handle %v", i, handle)}
+			}
+			continue
+		}
+		if src, _ := route["src"].(string); src == "" {
+			return &BuildOutputError{Path: p, Message: fmt.Sprintf("routes[%d]: src or handle is required", i)}
+		}
+	}
+
+	for i, cron := range cfg.Crons {
+		if cron.Path == "" || cron.Schedule == "" {
+			return &BuildOutputError{Path: p, Message: fmt.Sprintf("crons[%d]: path and schedule are required", i)}
+		}
+	}
+
+	return nil
+}
+
+// readFunctionConfig reads and validates the .vc-config.json of a function directory.
+func readFunctionConfig(funcDir string) (*BuildOutputFunctionConfig, error) {
+	p := filepath.Join(funcDir, ".vc-config.json")
+
+	data, err := os.ReadFile(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, &BuildOutputError{Path: p, Message: "file not found"}
+		}
+		return nil, err
+	}
+
+	var cfg BuildOutputFunctionConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, &BuildOutputError{Path: p, Message: fmt.Sprintf("invalid JSON: %v", err)}
+	}
+
+	if cfg.Runtime == "" {
+		return nil, &BuildOutputError{Path: p, Message: "runtime is required"}
+	}
+
+	if cfg.Runtime == "edge" {
+		if cfg.Entrypoint == "" {
+			return nil, &BuildOutputError{Path: p, Message: "entrypoint is required for edge functions"}
+		}
+		if _, err := os.Stat(filepath.Join(funcDir, filepath.FromSlash(cfg.Entrypoint))); err != nil {
+			return nil, &BuildOutputError{Path: p, Message: fmt.Sprintf("entrypoint %q not found", cfg.Entrypoint)}
+		}
+	} else {
+		if cfg.Handler == "" {
+			return nil, &BuildOutputError{Path: p, Message: "handler is required"}
+		}
+		if strings.HasPrefix(cfg.Runtime, "nodejs") {
+			if _, err := os.Stat(filepath.Join(funcDir, filepath.FromSlash(cfg.Handler))); err != nil {
+				return nil, &BuildOutputError{Path: p, Message: fmt.Sprintf("handler %q not found", cfg.Handler)}
+			}
+		}
+	}
+
+	if cfg.Memory != 0 && cfg.Memory < 128 {
+		return nil, &BuildOutputError{Path: p, Message: fmt.Sprintf("memory must be at least 128, got %d", cfg.Memory)}
+	}
+	if cfg.MaxDuration < 0 {
+		return nil, &BuildOutputError{Path: p, Message: "maxDuration must not be negative"}
+	}
+
+	return &cfg, nil
+}
+
+// validatePrerenderConfig checks a function's .prerender-config.json.
+func validatePrerenderConfig(p string) error {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return err
+	}
+
+	var cfg map[string]interface{}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return &BuildOutputError{Path: p, Message: fmt.Sprintf("invalid JSON: %v", err)}
+	}
+	if _, ok := cfg["expiration"]; !ok {
+		return &BuildOutputError{Path: p, Message: "expiration is required"}
+	}
+
+	return nil
+}
+
+// readBuildOutputFile computes the digest, size and mode of a file or symlink.
+func readBuildOutputFile(p string, d fs.DirEntry) (*BuildOutputFile, error) {
+	info, err := d.Info()
+	if err != nil {
+		return nil, err
+	}
+
+	mode := modeRegular
+	if info.Mode()&fs.ModeSymlink != 0 {
+		mode = modeSymlink
+	}
+	mode |= uint32(info.Mode().Perm())
+
+	data, err := readBuildOutputData(p, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha1.Sum(data)
+	return &BuildOutputFile{
+		SHA:  hex.EncodeToString(sum[:]),
+		Size: int64(len(data)),
+		Mode: mode,
+	}, nil
+}
+
+// readBuildOutputData returns the contents of a file, or the link target
+// of a symlink.
+func readBuildOutputData(p string, mode uint32) ([]byte, error) {
+	if mode&modeSymlink == modeSymlink {
+		target, err := os.Readlink(p)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(target), nil
+	}
+
+	return os.ReadFile(p)
+}
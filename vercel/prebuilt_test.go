@@ -0,0 +1,156 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeBuildOutput creates a Build Output API directory from a map of
+// slash-separated paths to file contents.
+func writeBuildOutput(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+	}
+	return dir
+}
+
+func validBuildOutput() map[string]string {
+	return map[string]string{
+		"config.json":       `{"version": 3, "routes": [{"handle": "filesystem"}, {"src": "/api/(.*)", "dest": "/api/hello"}]}`,
+		"static/index.html": "<html></html>",
+		"static/about.html": "<html></html>",
+		"functions/api/hello.func/.vc-config.json": `{"runtime": "nodejs18.x", "handler": "index.js", "launcherType": "Nodejs"}`,
+		"functions/api/hello.func/index.js":        "module.exports = () => {}",
+		"functions/edge.func/.vc-config.json":      `{"runtime": "edge", "entrypoint": "index.js"}`,
+		"functions/edge.func/index.js":             "export default () => {}",
+	}
+}
+
+func TestReadBuildOutput_Valid(t *testing.T) {
+	dir := writeBuildOutput(t, validBuildOutput())
+
+	out, err := ReadBuildOutput(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 3, out.Config.Version)
+	assert.Len(t, out.Files, 7)
+	assert.Equal(t, "index.js", out.Functions["functions/api/hello.func"].Handler)
+	assert.Equal(t, "edge", out.Functions["functions/edge.func"].Runtime)
+}
+
+func TestReadBuildOutput_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(files map[string]string)
+		badFile string
+	}{
+		{
+			name:    "missing config",
+			modify:  func(files map[string]string) { delete(files, "config.json") },
+			badFile: "config.json",
+		},
+		{
+			name:    "wrong version",
+			modify:  func(files map[string]string) { files["config.json"] = `{"version": 2}` },
+			badFile: "config.json",
+		},
+		{
+			name:    "route without src",
+			modify:  func(files map[string]string) { files["config.json"] = `{"version": 3, "routes": [{"dest": "/"}]}` },
+			badFile: "config.json",
+		},
+		{
+			name: "unknown override",
+			modify: func(files map[string]string) {
+				files["config.json"] = `{"version": 3, "overrides": {"missing.html": {"path": "missing"}}}`
+			},
+			badFile: "config.json",
+		},
+		{
+			name: "missing handler",
+			modify: func(files map[string]string) {
+				files["functions/api/hello.func/.vc-config.json"] = `{"runtime": "nodejs18.x"}`
+			},
+			badFile: "functions/api/hello.func/.vc-config.json",
+		},
+		{
+			name:    "missing vc-config",
+			modify:  func(files map[string]string) { delete(files, "functions/edge.func/.vc-config.json") },
+			badFile: "functions/edge.func/.vc-config.json",
+		},
+		{
+			name: "edge entrypoint not found",
+			modify: func(files map[string]string) {
+				files["functions/edge.func/.vc-config.json"] = `{"runtime": "edge", "entrypoint": "missing.js"}`
+			},
+			badFile: "functions/edge.func/.vc-config.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := validBuildOutput()
+			tt.modify(files)
+			dir := writeBuildOutput(t, files)
+
+			_, err := ReadBuildOutput(dir)
+			require.Error(t, err)
+
+			var outErr *BuildOutputError
+			require.ErrorAs(t, err, &outErr)
+			assert.Equal(t, filepath.Join(dir, filepath.FromSlash(tt.badFile)), outErr.Path)
+		})
+	}
+}
+
+func TestDeployPrebuilt_Success(t *testing.T) {
+	files := validBuildOutput()
+	dir := writeBuildOutput(t, files)
+
+	uploads := make(map[string]bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/files":
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+			uploads[r.Header.Get("x-vercel-digest")] = true
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"urls": []}`))
+		case "/v13/deployments":
+			var req CreateDeploymentRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			assert.True(t, req.Prebuilt)
+			assert.Len(t, req.Files, 7)
+			for _, f := range req.Files {
+				assert.Contains(t, f.File, ".vercel/output/")
+				assert.True(t, uploads[f.SHA], f.File)
+				assert.Empty(t, f.Data)
+			}
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(Deployment{ID: "dep-1", Name: req.Name, State: "BUILDING"})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	deployment, err := c.DeployPrebuilt(context.Background(), dir, CreateDeploymentRequest{Name: "my-app"})
+	require.NoError(t, err)
+	assert.Equal(t, "dep-1", deployment.ID)
+	// index.html and about.html share contents and are uploaded once.
+	assert.Len(t, uploads, 6)
+}
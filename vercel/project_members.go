@@ -0,0 +1,262 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var projectRoles = map[ProjectRole]bool{
+	ProjectRoleAdmin:     true,
+	ProjectRoleDeveloper: true,
+	ProjectRoleViewer:    true,
+}
+
+// ProjectMemberChange is a single change made by ApplyProjectMembers.
+type ProjectMemberChange struct {
+	Email    string
+	UID      string
+	Role     ProjectRole // the new role; empty when removing
+	Previous ProjectRole // the current role; empty when adding
+}
+
+// ProjectMemberPlan lists the changes that make a project's members match a
+// desired mapping of email to role.
+type ProjectMemberPlan struct {
+	Project string
+	Add     []ProjectMemberChange
+	Update  []ProjectMemberChange
+	Remove  []ProjectMemberChange
+	// Kept lists members missing from the desired mapping whose access
+	// comes from the team, such as team owners. They are never removed.
+	Kept []ProjectMemberChange
+	// Unresolved lists desired emails that do not belong to a team member.
+	Unresolved []string
+}
+
+// String renders the plan for review, one change per line.
+func (p *ProjectMemberPlan) String() string {
+	var b strings.Builder
+	for _, ch := range p.Add {
+		fmt.Fprintf(&b, "+ %s %s\n", ch.Email, ch.Role)
+	}
+	for _, ch := range p.Update {
+		fmt.Fprintf(&b, "~ %s %s -> %s\n", ch.Email, ch.Previous, ch.Role)
+	}
+	for _, ch := range p.Remove {
+		fmt.Fprintf(&b, "- %s %s\n", ch.Email, ch.Previous)
+	}
+	for _, ch := range p.Kept {
+		fmt.Fprintf(&b, "= %s %s (access comes from the team)\n", ch.Email, ch.Previous)
+	}
+	for _, email := range p.Unresolved {
+		fmt.Fprintf(&b, "? %s is not a member of the team\n", email)
+	}
+	if b.Len() == 0 {
+		return fmt.Sprintf("%s: no changes\n", p.Project)
+	}
+
+	return b.String()
+}
+
+// ListProjectMembers lists one page of the members of a project. Pass the
+// previous response's Pagination.Next as until to fetch the next page.
+func (c *Client) ListProjectMembers(ctx context.Context, projectIDOrName string, limit int, until int64) (*ListProjectMembersResponse, error) {
+	query := make(map[string]string)
+	if limit > 0 {
+		query["limit"] = strconv.Itoa(limit)
+	}
+	if until > 0 {
+		query["until"] = strconv.FormatInt(until, 10)
+	}
+
+	var resp ListProjectMembersResponse
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v1/projects/%s/members", projectIDOrName), query, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// AddProjectMember adds a team member to a project with a role. The request
+// is validated before it is sent.
+func (c *Client) AddProjectMember(ctx context.Context, projectIDOrName string, req AddProjectMemberRequest) error {
+	if req.UID == "" && req.Username == "" && req.Email == "" {
+		return &ValidationError{Field: "uid", Message: "one of uid, username or email is required"}
+	}
+	if err := validateProjectRole(req.Role); err != nil {
+		return err
+	}
+
+	return c.doRequest(ctx, "POST", fmt.Sprintf("/v1/projects/%s/members", projectIDOrName), nil, req, nil)
+}
+
+// UpdateProjectMember changes the role of a project member.
+func (c *Client) UpdateProjectMember(ctx context.Context, projectIDOrName, uid string, role ProjectRole) error {
+	if err := validateProjectRole(role); err != nil {
+		return err
+	}
+
+	body := struct {
+		Role ProjectRole `json:"role"`
+	}{Role: role}
+
+	return c.doRequest(ctx, "PATCH", fmt.Sprintf("/v1/projects/%s/members/%s", projectIDOrName, uid), nil, body, nil)
+}
+
+// RemoveProjectMember removes a member from a project.
+func (c *Client) RemoveProjectMember(ctx context.Context, projectIDOrName, uid string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v1/projects/%s/members/%s", projectIDOrName, uid), nil, nil, nil)
+}
+
+// PlanProjectMembers compares the members of a project with a desired
+// mapping of email to role. Emails are resolved to users through the members
+// of teamID. Members missing from the mapping are removed, except team owners
+// and members without a direct project role, whose access comes from the
+// team; they are listed in Kept. Such members are added with the desired
+// role only when the team grants them less. Nothing is changed; pass the plan to
+// ApplyProjectMembers.
+func (c *Client) PlanProjectMembers(ctx context.Context, teamID, projectIDOrName string, desired map[string]ProjectRole) (*ProjectMemberPlan, error) {
+	for email, role := range desired {
+		if err := validateProjectRole(role); err != nil {
+			return nil, fmt.Errorf("%s: %w", email, err)
+		}
+	}
+
+	teamMembers, err := c.ListAllTeamMembers(ctx, teamID, ListTeamMembersOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	uidByEmail := make(map[string]string)
+	for _, m := range teamMembers {
+		if m.User.Email != "" {
+			uidByEmail[strings.ToLower(m.User.Email)] = m.User.ID
+		}
+	}
+
+	current, err := c.listAllProjectMembers(ctx, projectIDOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	byUID := make(map[string]ProjectMember)
+	for _, m := range current {
+		byUID[m.UID] = m
+	}
+
+	plan := &ProjectMemberPlan{Project: projectIDOrName}
+	wanted := make(map[string]bool)
+	for email, role := range desired {
+		uid, ok := uidByEmail[strings.ToLower(email)]
+		if !ok {
+			plan.Unresolved = append(plan.Unresolved, email)
+			continue
+		}
+		wanted[uid] = true
+
+		existing, ok := byUID[uid]
+		switch {
+		case ok && projectMemberInherited(existing):
+			// There is no direct membership to update. Add one only if the
+			// team does not already grant at least the desired role.
+			if projectRoleRank[effectiveProjectRole(existing)] < projectRoleRank[role] {
+				plan.Add = append(plan.Add, ProjectMemberChange{Email: email, UID: uid, Role: role})
+			}
+		case !ok:
+			plan.Add = append(plan.Add, ProjectMemberChange{Email: email, UID: uid, Role: role})
+		case existing.Role != role:
+			plan.Update = append(plan.Update, ProjectMemberChange{Email: email, UID: uid, Role: role, Previous: existing.Role})
+		}
+	}
+
+	for _, m := range current {
+		if wanted[m.UID] {
+			continue
+		}
+
+		ch := ProjectMemberChange{Email: m.Email, UID: m.UID, Previous: m.Role}
+		if projectMemberInherited(m) {
+			ch.Previous = effectiveProjectRole(m)
+			plan.Kept = append(plan.Kept, ch)
+			continue
+		}
+		plan.Remove = append(plan.Remove, ch)
+	}
+
+	for _, changes := range [][]ProjectMemberChange{plan.Add, plan.Update, plan.Remove, plan.Kept} {
+		sort.Slice(changes, func(i, j int) bool {
+			return changes[i].Email < changes[j].Email
+		})
+	}
+	sort.Strings(plan.Unresolved)
+
+	return plan, nil
+}
+
+// ApplyProjectMembers applies a plan from PlanProjectMembers. Members are
+// added and updated before any are removed.
+func (c *Client) ApplyProjectMembers(ctx context.Context, plan *ProjectMemberPlan) error {
+	for _, ch := range plan.Add {
+		if err := c.AddProjectMember(ctx, plan.Project, AddProjectMemberRequest{UID: ch.UID, Role: ch.Role}); err != nil {
+			return fmt.Errorf("failed to add %s to %s: %w", ch.Email, plan.Project, err)
+		}
+	}
+	for _, ch := range plan.Update {
+		if err := c.UpdateProjectMember(ctx, plan.Project, ch.UID, ch.Role); err != nil {
+			return fmt.Errorf("failed to update %s in %s: %w", ch.Email, plan.Project, err)
+		}
+	}
+	for _, ch := range plan.Remove {
+		if err := c.RemoveProjectMember(ctx, plan.Project, ch.UID); err != nil {
+			return fmt.Errorf("failed to remove %s from %s: %w", ch.Email, plan.Project, err)
+		}
+	}
+
+	return nil
+}
+
+// listAllProjectMembers pages through ListProjectMembers and returns every
+// member.
+func (c *Client) listAllProjectMembers(ctx context.Context, projectIDOrName string) ([]ProjectMember, error) {
+	var members []ProjectMember
+	var until int64
+	for {
+		resp, err := c.ListProjectMembers(ctx, projectIDOrName, 100, until)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, resp.Members...)
+		if resp.Pagination.Next == 0 || resp.Pagination.Next == until || len(resp.Members) == 0 {
+			return members, nil
+		}
+		until = resp.Pagination.Next
+	}
+}
+
+// projectMemberInherited reports whether a project member's access comes
+// from the team rather than a direct project role.
+func projectMemberInherited(m ProjectMember) bool {
+	return m.TeamRole == TeamRoleOwner || m.Role == ""
+}
+
+// effectiveProjectRole returns the direct project role of a member, or the
+// role computed from the team when there is none.
+func effectiveProjectRole(m ProjectMember) ProjectRole {
+	if m.Role != "" {
+		return m.Role
+	}
+
+	return m.ComputedProjectRole
+}
+
+func validateProjectRole(role ProjectRole) error {
+	if !projectRoles[role] {
+		return &ValidationError{Field: "role", Message: fmt.Sprintf("unknown project role %q", role)}
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListProjectMembers_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/projects/prj-1/members", r.URL.Path)
+		assert.Equal(t, "20", r.URL.Query().Get("limit"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"members":[{"uid":"user-1","email":"john@example.com","role":"ADMIN","computedProjectRole":"ADMIN","teamRole":"OWNER"}],"pagination":{"hasNext":false,"count":1}}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	resp, err := c.ListProjectMembers(context.Background(), "prj-1", 20, 0)
+	require.NoError(t, err)
+	require.Len(t, resp.Members, 1)
+	assert.Equal(t, ProjectRoleAdmin, resp.Members[0].Role)
+	assert.Equal(t, TeamRoleOwner, resp.Members[0].TeamRole)
+}
+
+func TestAddProjectMember_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/projects/prj-1/members", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"email": "jane@example.com", "role": "PROJECT_VIEWER"}, body)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id":"prj-1"}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	err := c.AddProjectMember(context.Background(), "prj-1", AddProjectMemberRequest{Email: "jane@example.com", Role: ProjectRoleViewer})
+	require.NoError(t, err)
+
+	err = c.AddProjectMember(context.Background(), "prj-1", AddProjectMemberRequest{Email: "jane@example.com", Role: "OWNER"})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "role", validationErr.Field)
+}
+
+func TestPlanAndApplyProjectMembers(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/teams/team-1/members":
+			w.Write([]byte(`{"members":[
+				{"user":{"id":"user-1","email":"john@example.com"}},
+				{"user":{"id":"user-2","email":"jane@example.com"}},
+				{"user":{"id":"user-3","email":"bob@example.com"}},
+				{"user":{"id":"user-4","email":"eve@example.com"}}
+			]}`))
+		case "GET /v1/projects/prj-1/members":
+			w.Write([]byte(`{"members":[
+				{"uid":"user-1","email":"john@example.com","role":"ADMIN"},
+				{"uid":"user-2","email":"jane@example.com","role":"PROJECT_VIEWER"},
+				{"uid":"user-4","email":"eve@example.com","role":"PROJECT_DEVELOPER"},
+				{"uid":"user-5","email":"owner@example.com","role":"ADMIN","computedProjectRole":"ADMIN","teamRole":"OWNER"},
+				{"uid":"user-6","email":"member@example.com","computedProjectRole":"PROJECT_DEVELOPER","teamRole":"MEMBER"}
+			]}`))
+		default:
+			calls = append(calls, r.Method+" "+r.URL.Path)
+			w.Write([]byte(`{"id":"prj-1"}`))
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	plan, err := c.PlanProjectMembers(context.Background(), "team-1", "prj-1", map[string]ProjectRole{
+		"John@example.com":    ProjectRoleAdmin,
+		"jane@example.com":    ProjectRoleDeveloper,
+		"bob@example.com":     ProjectRoleViewer,
+		"unknown@example.com": ProjectRoleViewer,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []ProjectMemberChange{{Email: "bob@example.com", UID: "user-3", Role: ProjectRoleViewer}}, plan.Add)
+	assert.Equal(t, []ProjectMemberChange{{Email: "jane@example.com", UID: "user-2", Role: ProjectRoleDeveloper, Previous: ProjectRoleViewer}}, plan.Update)
+	assert.Equal(t, []ProjectMemberChange{{Email: "eve@example.com", UID: "user-4", Previous: ProjectRoleDeveloper}}, plan.Remove)
+	assert.Equal(t, []ProjectMemberChange{
+		{Email: "member@example.com", UID: "user-6", Previous: ProjectRoleDeveloper},
+		{Email: "owner@example.com", UID: "user-5", Previous: ProjectRoleAdmin},
+	}, plan.Kept)
+	assert.Equal(t, []string{"unknown@example.com"}, plan.Unresolved)
+	assert.Equal(t, "+ bob@example.com PROJECT_VIEWER\n"+
+		"~ jane@example.com PROJECT_VIEWER -> PROJECT_DEVELOPER\n"+
+		"- eve@example.com PROJECT_DEVELOPER\n"+
+		"= member@example.com PROJECT_DEVELOPER (access comes from the team)\n"+
+		"= owner@example.com ADMIN (access comes from the team)\n"+
+		"? unknown@example.com is not a member of the team\n", plan.String())
+
+	require.NoError(t, c.ApplyProjectMembers(context.Background(), plan))
+	assert.Equal(t, []string{
+		"POST /v1/projects/prj-1/members",
+		"PATCH /v1/projects/prj-1/members/user-2",
+		"DELETE /v1/projects/prj-1/members/user-4",
+	}, calls)
+}
+
+func TestPlanProjectMembers_InheritedMembers(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/teams/team-1/members":
+			w.Write([]byte(`{"members":[
+				{"user":{"id":"user-5","email":"owner@example.com"}},
+				{"user":{"id":"user-6","email":"member@example.com"}},
+				{"user":{"id":"user-7","email":"dev@example.com"}}
+			]}`))
+		case "GET /v1/projects/prj-1/members":
+			w.Write([]byte(`{"members":[
+				{"uid":"user-5","email":"owner@example.com","role":"ADMIN","computedProjectRole":"ADMIN","teamRole":"OWNER"},
+				{"uid":"user-6","email":"member@example.com","computedProjectRole":"PROJECT_DEVELOPER","teamRole":"MEMBER"},
+				{"uid":"user-7","email":"dev@example.com","computedProjectRole":"PROJECT_DEVELOPER","teamRole":"MEMBER"}
+			]}`))
+		default:
+			calls = append(calls, r.Method+" "+r.URL.Path)
+			if r.Method == "POST" {
+				var body AddProjectMemberRequest
+				json.NewDecoder(r.Body).Decode(&body)
+				assert.Equal(t, "user-6", body.UID)
+				assert.Equal(t, ProjectRoleAdmin, body.Role)
+			}
+			w.Write([]byte(`{"id":"prj-1"}`))
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	plan, err := c.PlanProjectMembers(context.Background(), "team-1", "prj-1", map[string]ProjectRole{
+		"owner@example.com":  ProjectRoleViewer,    // team owners keep their access
+		"member@example.com": ProjectRoleAdmin,     // more than the team grants
+		"dev@example.com":    ProjectRoleDeveloper, // already granted by the team
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []ProjectMemberChange{{Email: "member@example.com", UID: "user-6", Role: ProjectRoleAdmin}}, plan.Add)
+	assert.Empty(t, plan.Update)
+	assert.Empty(t, plan.Remove)
+
+	require.NoError(t, c.ApplyProjectMembers(context.Background(), plan))
+	assert.Equal(t, []string{"POST /v1/projects/prj-1/members"}, calls)
+}
@@ -48,3 +48,21 @@ func (c *Client) UpdateProject(ctx context.Context, idOrName string, req UpdateP
 func (c *Client) DeleteProject(ctx context.Context, idOrName string) error {
 	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v9/projects/%s", idOrName), nil, nil, nil)
 }
+
+// listAllProjects pages through ListProjects and returns every project.
+func (c *Client) listAllProjects(ctx context.Context) ([]Project, error) {
+	const pageSize = 100
+
+	var projects []Project
+	for {
+		resp, err := c.ListProjects(ctx, pageSize, len(projects))
+		if err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, resp.Projects...)
+		if len(resp.Projects) < pageSize || (resp.Pagination.Total > 0 && len(projects) >= resp.Pagination.Total) {
+			return projects, nil
+		}
+	}
+}
@@ -3,18 +3,53 @@ package vercel
 import (
 	"context"
 	"fmt"
+	"strconv"
 )
 
-// ListSecrets lists all secrets for the authenticated user or team.
+// ListSecrets lists the first page of secrets for the authenticated user or
+// team.
 func (c *Client) ListSecrets(ctx context.Context) (*ListSecretsResponse, error) {
+	return c.ListSecretsWithOptions(ctx, ListSecretsOptions{})
+}
+
+// ListSecretsWithOptions lists one page of secrets for the authenticated user
+// or team.
+func (c *Client) ListSecretsWithOptions(ctx context.Context, opts ListSecretsOptions) (*ListSecretsResponse, error) {
+	query := make(map[string]string)
+	if opts.Limit > 0 {
+		query["limit"] = strconv.Itoa(opts.Limit)
+	}
+	if opts.Until > 0 {
+		query["until"] = strconv.FormatInt(opts.Until, 10)
+	}
+
 	var resp ListSecretsResponse
-	if err := c.doRequest(ctx, "GET", "/v2/secrets", nil, nil, &resp); err != nil {
+	if err := c.doRequest(ctx, "GET", "/v2/secrets", query, nil, &resp); err != nil {
 		return nil, err
 	}
 
 	return &resp, nil
 }
 
+// listAllSecrets pages through ListSecretsWithOptions and returns every
+// secret.
+func (c *Client) listAllSecrets(ctx context.Context) ([]Secret, error) {
+	var secrets []Secret
+	opts := ListSecretsOptions{Limit: 100}
+	for {
+		resp, err := c.ListSecretsWithOptions(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		secrets = append(secrets, resp.Secrets...)
+		if resp.Pagination.Next == 0 || resp.Pagination.Next == opts.Until || len(resp.Secrets) == 0 {
+			return secrets, nil
+		}
+		opts.Until = resp.Pagination.Next
+	}
+}
+
 // GetSecret retrieves a secret by ID.
 func (c *Client) GetSecret(ctx context.Context, secretID string) (*Secret, error) {
 	var secret Secret
@@ -25,6 +60,17 @@ func (c *Client) GetSecret(ctx context.Context, secretID string) (*Secret, error
 	return &secret, nil
 }
 
+// GetDecryptedSecret retrieves a secret by ID or name with its value
+// decrypted. Only secrets created as decryptable can be decrypted.
+func (c *Client) GetDecryptedSecret(ctx context.Context, idOrName string) (*Secret, error) {
+	var secret Secret
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v3/secrets/%s", idOrName), map[string]string{"decrypt": "true"}, nil, &secret); err != nil {
+		return nil, err
+	}
+
+	return &secret, nil
+}
+
 // CreateSecret creates a new secret.
 func (c *Client) CreateSecret(ctx context.Context, req CreateSecretRequest) (*Secret, error) {
 	var secret Secret
@@ -0,0 +1,281 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SecretMigrationOptions configures PlanSecretMigration and ApplySecretMigration.
+type SecretMigrationOptions struct {
+	// Values supplies secret values by secret name, for secrets that were
+	// not created as decryptable.
+	Values map[string]string
+	// DeleteSecrets deletes, after applying, every secret that is no longer
+	// referenced by any environment variable.
+	DeleteSecrets bool
+}
+
+// SecretMigrationItem is an environment variable that references a secret.
+type SecretMigrationItem struct {
+	ProjectID   string
+	ProjectName string
+	EnvVar      EnvVar
+	Secret      *Secret // nil when the reference could not be resolved
+	Err         error   // why the variable cannot be migrated, if anything
+
+	value string
+}
+
+// SecretMigrationPlan lists the environment variables that reference legacy
+// secrets and the secrets that can be deleted once they are migrated.
+type SecretMigrationPlan struct {
+	Items []SecretMigrationItem
+	// Deletable lists secrets whose references can all be migrated, plus
+	// secrets referenced by nothing. It is empty when any reference could
+	// not be resolved, since that reference may be to any of the secrets.
+	Deletable []Secret
+}
+
+// SecretMigrationReport reports the outcome of ApplySecretMigration.
+type SecretMigrationReport struct {
+	Migrated       []SecretMigrationItem
+	Failed         []SecretMigrationItem
+	DeletedSecrets []Secret
+	// DeleteErrors maps secret names to the error returned when deleting them.
+	DeleteErrors map[string]error
+}
+
+// String renders the plan for review. Secret values are never included.
+func (p *SecretMigrationPlan) String() string {
+	var b strings.Builder
+	for _, item := range p.Items {
+		secret := "?"
+		if item.Secret != nil {
+			secret = item.Secret.Name
+		}
+
+		fmt.Fprintf(&b, "%s: %s (%s) <- @%s", item.ProjectName, item.EnvVar.Key, formatEnvTargets(item.EnvVar.Target), secret)
+		if item.Err != nil {
+			fmt.Fprintf(&b, ": %v", item.Err)
+		}
+		b.WriteString("\n")
+	}
+	for _, secret := range p.Deletable {
+		fmt.Fprintf(&b, "delete secret @%s\n", secret.Name)
+	}
+	if p.hasUnresolved() {
+		b.WriteString("no secrets will be deleted: some references could not be resolved\n")
+	}
+
+	return b.String()
+}
+
+// PlanSecretMigration scans the environment variables of every project for
+// references to legacy secrets and resolves each one to its secret and value.
+// Nothing is changed; review the plan and pass it to ApplySecretMigration.
+func (c *Client) PlanSecretMigration(ctx context.Context, opts SecretMigrationOptions) (*SecretMigrationPlan, error) {
+	secrets, err := c.listAllSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*Secret)
+	byName := make(map[string]*Secret)
+	for i := range secrets {
+		s := &secrets[i]
+		byID[s.ID] = s
+		byName[s.Name] = s
+	}
+
+	projects, err := c.listAllProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &SecretMigrationPlan{}
+	values := make(map[string]string)
+	valueErrs := make(map[string]error)
+	blocked := make(map[string]bool)
+	referenced := make(map[string]bool)
+
+	for _, project := range projects {
+		envVars, err := c.ListEnvVars(ctx, project.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list environment variables of %s: %w", project.Name, err)
+		}
+
+		for _, ev := range envVars {
+			if ev.Type != EnvTypeSecret {
+				continue
+			}
+
+			item := SecretMigrationItem{ProjectID: project.ID, ProjectName: project.Name, EnvVar: ev}
+
+			ref := strings.TrimPrefix(ev.Value, "@")
+			secret := byID[ref]
+			if secret == nil {
+				secret = byName[ref]
+			}
+			if secret == nil {
+				item.Err = fmt.Errorf("secret %q not found", ev.Value)
+				plan.Items = append(plan.Items, item)
+				continue
+			}
+
+			item.Secret = secret
+			referenced[secret.ID] = true
+
+			if _, ok := values[secret.ID]; !ok && valueErrs[secret.ID] == nil {
+				value, err := c.resolveSecretValue(ctx, secret, opts.Values)
+				if err != nil {
+					valueErrs[secret.ID] = err
+				} else {
+					values[secret.ID] = value
+				}
+			}
+
+			item.value = values[secret.ID]
+			item.Err = valueErrs[secret.ID]
+			if item.Err != nil {
+				blocked[secret.ID] = true
+			}
+
+			plan.Items = append(plan.Items, item)
+		}
+	}
+
+	if !plan.hasUnresolved() {
+		for _, s := range secrets {
+			if !blocked[s.ID] {
+				plan.Deletable = append(plan.Deletable, s)
+			}
+		}
+	}
+
+	sort.SliceStable(plan.Items, func(i, j int) bool {
+		if plan.Items[i].ProjectName != plan.Items[j].ProjectName {
+			return plan.Items[i].ProjectName < plan.Items[j].ProjectName
+		}
+		return plan.Items[i].EnvVar.Key < plan.Items[j].EnvVar.Key
+	})
+
+	return plan, nil
+}
+
+// ApplySecretMigration converts every resolvable item of a plan into a
+// sensitive environment variable with the same key, targets and branch.
+// Sensitive variables cannot target development, so a development target is
+// split off into a separate encrypted variable. Items that fail, or that
+// could not be resolved while planning, are reported as failed and keep
+// their secret from being deleted. If any reference could not be resolved to
+// a secret, no secret is deleted and an error is returned after migrating.
+func (c *Client) ApplySecretMigration(ctx context.Context, plan *SecretMigrationPlan, opts SecretMigrationOptions) (*SecretMigrationReport, error) {
+	report := &SecretMigrationReport{DeleteErrors: make(map[string]error)}
+	failedSecrets := make(map[string]bool)
+
+	for _, item := range plan.Items {
+		if item.Err == nil {
+			item.Err = c.migrateSecretEnvVar(ctx, item)
+		}
+
+		if item.Err != nil {
+			report.Failed = append(report.Failed, item)
+			if item.Secret != nil {
+				failedSecrets[item.Secret.ID] = true
+			}
+			continue
+		}
+
+		report.Migrated = append(report.Migrated, item)
+	}
+
+	if !opts.DeleteSecrets {
+		return report, nil
+	}
+	if plan.hasUnresolved() {
+		return report, fmt.Errorf("not deleting secrets: some references could not be resolved")
+	}
+
+	for _, secret := range plan.Deletable {
+		if failedSecrets[secret.ID] {
+			continue
+		}
+
+		if err := c.DeleteSecret(ctx, secret.ID); err != nil {
+			report.DeleteErrors[secret.Name] = err
+			continue
+		}
+		report.DeletedSecrets = append(report.DeletedSecrets, secret)
+	}
+
+	return report, nil
+}
+
+// hasUnresolved reports whether any item references a secret that could not
+// be found.
+func (p *SecretMigrationPlan) hasUnresolved() bool {
+	for _, item := range p.Items {
+		if item.Secret == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// migrateSecretEnvVar rewrites a single secret reference in place.
+func (c *Client) migrateSecretEnvVar(ctx context.Context, item SecretMigrationItem) error {
+	ev := item.EnvVar
+	others := withoutEnvTarget(ev.Target, EnvTargetDevelopment)
+	hasDevelopment := len(others) < len(ev.Target)
+
+	if len(others) == 0 {
+		_, err := c.UpdateEnvVar(ctx, item.ProjectID, ev.ID, UpdateEnvVarRequest{
+			Type:  EnvTypeEncrypted,
+			Value: item.value,
+		})
+		return err
+	}
+
+	if _, err := c.UpdateEnvVar(ctx, item.ProjectID, ev.ID, UpdateEnvVarRequest{
+		Type:   EnvTypeSensitive,
+		Value:  item.value,
+		Target: others,
+	}); err != nil {
+		return err
+	}
+
+	if hasDevelopment {
+		if _, err := c.CreateEnvVar(ctx, item.ProjectID, CreateEnvVarRequest{
+			Key:     ev.Key,
+			Value:   item.value,
+			Type:    EnvTypeEncrypted,
+			Target:  []EnvTarget{EnvTargetDevelopment},
+			Comment: ev.Comment,
+		}); err != nil {
+			return fmt.Errorf("migrated %s but failed to create its development variable: %w", ev.Key, err)
+		}
+	}
+
+	return nil
+}
+
+// resolveSecretValue returns a secret's value from the supplied values or by
+// decrypting it.
+func (c *Client) resolveSecretValue(ctx context.Context, secret *Secret, values map[string]string) (string, error) {
+	if value, ok := values[secret.Name]; ok {
+		return value, nil
+	}
+
+	decrypted, err := c.GetDecryptedSecret(ctx, secret.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret %s: %w", secret.Name, err)
+	}
+	if decrypted.Value == "" {
+		return "", fmt.Errorf("secret %s is not decryptable; supply its value in SecretMigrationOptions.Values", secret.Name)
+	}
+
+	return decrypted.Value, nil
+}
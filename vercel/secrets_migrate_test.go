@@ -0,0 +1,153 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// secretMigrationServer serves two projects referencing secrets, listed
+// across two pages. With missing set, one variable references a secret that
+// does not exist.
+func secretMigrationServer(t *testing.T, calls *[]string, missing bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/v2/secrets":
+			var resp ListSecretsResponse
+			if r.URL.Query().Get("until") == "" {
+				resp.Secrets = []Secret{{ID: "sec_db", Name: "db-url"}, {ID: "sec_opaque", Name: "opaque"}}
+				resp.Pagination.Next = 100
+			} else {
+				assert.Equal(t, "100", r.URL.Query().Get("until"))
+				resp.Secrets = []Secret{{ID: "sec_unused", Name: "unused"}}
+			}
+			json.NewEncoder(w).Encode(resp)
+		case r.Method == "GET" && r.URL.Path == "/v9/projects":
+			resp := ListProjectsResponse{Projects: []Project{{ID: "prj_1", Name: "web"}, {ID: "prj_2", Name: "api"}}}
+			resp.Pagination.Total = 2
+			json.NewEncoder(w).Encode(resp)
+		case r.Method == "GET" && r.URL.Path == "/v9/projects/prj_1/env":
+			json.NewEncoder(w).Encode(map[string]interface{}{"env": []EnvVar{
+				{ID: "env_db", Key: "DATABASE_URL", Value: "sec_db", Type: EnvTypeSecret, Target: []EnvTarget{EnvTargetProduction, EnvTargetDevelopment}},
+				{ID: "env_plain", Key: "PLAIN", Value: "x", Type: EnvTypePlain, Target: []EnvTarget{EnvTargetProduction}},
+			}})
+		case r.Method == "GET" && r.URL.Path == "/v9/projects/prj_2/env":
+			envVars := []EnvVar{
+				{ID: "env_db2", Key: "DB", Value: "@db-url", Type: EnvTypeSecret, Target: []EnvTarget{EnvTargetPreview}},
+				{ID: "env_opaque", Key: "TOKEN", Value: "sec_opaque", Type: EnvTypeSecret, Target: []EnvTarget{EnvTargetProduction}},
+			}
+			if missing {
+				envVars = append(envVars, EnvVar{ID: "env_missing", Key: "GONE", Value: "@deleted", Type: EnvTypeSecret, Target: []EnvTarget{EnvTargetProduction}})
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"env": envVars})
+		case r.Method == "GET" && r.URL.Path == "/v3/secrets/sec_db":
+			assert.Equal(t, "true", r.URL.Query().Get("decrypt"))
+			json.NewEncoder(w).Encode(Secret{ID: "sec_db", Name: "db-url", Value: "postgres://db"})
+		case r.Method == "GET" && r.URL.Path == "/v3/secrets/sec_opaque":
+			json.NewEncoder(w).Encode(Secret{ID: "sec_opaque", Name: "opaque"})
+		default:
+			*calls = append(*calls, r.Method+" "+r.URL.Path)
+			if r.Method == "PATCH" {
+				var req UpdateEnvVarRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				assert.Equal(t, "postgres://db", req.Value)
+				if r.URL.Path == "/v9/projects/prj_1/env/env_db" {
+					assert.Equal(t, EnvTypeSensitive, req.Type)
+					assert.Equal(t, []EnvTarget{EnvTargetProduction}, req.Target)
+				}
+			}
+			if r.Method == "POST" {
+				var req CreateEnvVarRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				assert.Equal(t, EnvTypeEncrypted, req.Type)
+				assert.Equal(t, []EnvTarget{EnvTargetDevelopment}, req.Target)
+			}
+			json.NewEncoder(w).Encode(map[string]string{})
+		}
+	}))
+}
+
+func TestPlanSecretMigration(t *testing.T) {
+	var calls []string
+	server := secretMigrationServer(t, &calls, false)
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	plan, err := c.PlanSecretMigration(context.Background(), SecretMigrationOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, calls)
+
+	require.Len(t, plan.Items, 3)
+	assert.Equal(t, "DB", plan.Items[0].EnvVar.Key)
+	assert.NoError(t, plan.Items[0].Err)
+	assert.Equal(t, "TOKEN", plan.Items[1].EnvVar.Key)
+	assert.Error(t, plan.Items[1].Err)
+	assert.Equal(t, "DATABASE_URL", plan.Items[2].EnvVar.Key)
+
+	var deletable []string
+	for _, s := range plan.Deletable {
+		deletable = append(deletable, s.Name)
+	}
+	assert.Equal(t, []string{"db-url", "unused"}, deletable)
+	assert.NotContains(t, plan.String(), "postgres://db")
+}
+
+func TestApplySecretMigration(t *testing.T) {
+	var calls []string
+	server := secretMigrationServer(t, &calls, false)
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+	opts := SecretMigrationOptions{DeleteSecrets: true}
+
+	plan, err := c.PlanSecretMigration(context.Background(), opts)
+	require.NoError(t, err)
+
+	report, err := c.ApplySecretMigration(context.Background(), plan, opts)
+	require.NoError(t, err)
+	assert.Len(t, report.Migrated, 2)
+	assert.Len(t, report.Failed, 1)
+	assert.Len(t, report.DeletedSecrets, 2)
+
+	assert.Equal(t, []string{
+		"PATCH /v9/projects/prj_2/env/env_db2",
+		"PATCH /v9/projects/prj_1/env/env_db",
+		"POST /v9/projects/prj_1/env",
+		"DELETE /v2/secrets/sec_db",
+		"DELETE /v2/secrets/sec_unused",
+	}, calls)
+}
+
+func TestSecretMigration_UnresolvedReferenceBlocksDeletes(t *testing.T) {
+	var calls []string
+	server := secretMigrationServer(t, &calls, true)
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+	opts := SecretMigrationOptions{DeleteSecrets: true}
+
+	plan, err := c.PlanSecretMigration(context.Background(), opts)
+	require.NoError(t, err)
+	require.Len(t, plan.Items, 4)
+	assert.Equal(t, "GONE", plan.Items[1].EnvVar.Key)
+	assert.Nil(t, plan.Items[1].Secret)
+	assert.Error(t, plan.Items[1].Err)
+	assert.Empty(t, plan.Deletable)
+	assert.Contains(t, plan.String(), "no secrets will be deleted")
+
+	// A plan built by hand must not delete anything either.
+	plan.Deletable = []Secret{{ID: "sec_unused", Name: "unused"}}
+	report, err := c.ApplySecretMigration(context.Background(), plan, opts)
+	assert.Error(t, err)
+	assert.Len(t, report.Migrated, 2)
+	assert.Empty(t, report.DeletedSecrets)
+	for _, call := range calls {
+		assert.NotContains(t, call, "DELETE")
+	}
+}
@@ -3,8 +3,27 @@ package vercel
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 )
 
+var teamSlugPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,46}[a-z0-9])?$`)
+
+var teamPolicyValues = map[string]bool{
+	"on":      true,
+	"off":     true,
+	"default": true,
+}
+
+var teamRoles = map[TeamRole]bool{
+	TeamRoleOwner:     true,
+	TeamRoleMember:    true,
+	TeamRoleDeveloper: true,
+	TeamRoleViewer:    true,
+	TeamRoleBilling:   true,
+}
+
 // ListTeams lists all teams for the authenticated user.
 func (c *Client) ListTeams(ctx context.Context) (*ListTeamsResponse, error) {
 	var resp ListTeamsResponse
@@ -25,12 +44,240 @@ func (c *Client) GetTeam(ctx context.Context, teamID string) (*Team, error) {
 	return &team, nil
 }
 
-// ListTeamMembers lists all members of a team.
+// CreateTeam creates a team. The authenticated user becomes its owner.
+func (c *Client) CreateTeam(ctx context.Context, req CreateTeamRequest) (*Team, error) {
+	if err := validateTeamSlug(req.Slug); err != nil {
+		return nil, err
+	}
+
+	var team Team
+	if err := c.doRequest(ctx, "POST", "/v1/teams", nil, req, &team); err != nil {
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+// UpdateTeam updates the name, slug, description or settings of a team. The
+// request is validated before it is sent.
+func (c *Client) UpdateTeam(ctx context.Context, teamID string, req UpdateTeamRequest) (*Team, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var team Team
+	if err := c.doRequest(ctx, "PATCH", fmt.Sprintf("/v2/teams/%s", teamID), nil, req, &team); err != nil {
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+// DeleteTeam deletes a team along with its projects and deployments.
+func (c *Client) DeleteTeam(ctx context.Context, teamID string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v1/teams/%s", teamID), nil, nil, nil)
+}
+
+// ListTeamMembers lists the first page of members of a team.
 func (c *Client) ListTeamMembers(ctx context.Context, teamID string) (*ListTeamMembersResponse, error) {
+	return c.ListTeamMembersWithOptions(ctx, teamID, ListTeamMembersOptions{})
+}
+
+// ListTeamMembersWithOptions lists one page of members of a team, filtered
+// by opts.
+func (c *Client) ListTeamMembersWithOptions(ctx context.Context, teamID string, opts ListTeamMembersOptions) (*ListTeamMembersResponse, error) {
+	query := map[string]string{
+		"role":                        string(opts.Role),
+		"search":                      opts.Search,
+		"eligibleMembersForProjectId": opts.EligibleMembersForProjectID,
+		"excludeProject":              opts.ExcludeProject,
+	}
+	if opts.Limit > 0 {
+		query["limit"] = strconv.Itoa(opts.Limit)
+	}
+	if opts.Until > 0 {
+		query["until"] = strconv.FormatInt(opts.Until, 10)
+	}
+
 	var resp ListTeamMembersResponse
-	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v2/teams/%s/members", teamID), nil, nil, &resp); err != nil {
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v2/teams/%s/members", teamID), query, nil, &resp); err != nil {
 		return nil, err
 	}
 
 	return &resp, nil
 }
+
+// ListAllTeamMembers returns every member of a team matching opts,
+// following pagination.
+func (c *Client) ListAllTeamMembers(ctx context.Context, teamID string, opts ListTeamMembersOptions) ([]TeamMember, error) {
+	var members []TeamMember
+	it := c.IterateTeamMembers(ctx, teamID, opts)
+	for it.Next() {
+		members = append(members, it.Member())
+	}
+
+	return members, it.Err()
+}
+
+// TeamMemberIterator iterates over the members of a team, fetching pages
+// as needed.
+//
+//	it := client.IterateTeamMembers(ctx, teamID, vercel.ListTeamMembersOptions{})
+//	for it.Next() {
+//		member := it.Member()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type TeamMemberIterator struct {
+	c      *Client
+	ctx    context.Context
+	teamID string
+	opts   ListTeamMembersOptions
+
+	page    []TeamMember
+	current TeamMember
+	last    bool
+	err     error
+}
+
+// IterateTeamMembers returns an iterator over the members of a team
+// matching opts, starting at opts.Until.
+func (c *Client) IterateTeamMembers(ctx context.Context, teamID string, opts ListTeamMembersOptions) *TeamMemberIterator {
+	return &TeamMemberIterator{c: c, ctx: ctx, teamID: teamID, opts: opts}
+}
+
+// Next advances to the next member, reporting false when there are no more
+// members or an error occurred.
+func (it *TeamMemberIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.last || it.err != nil {
+			return false
+		}
+
+		resp, err := it.c.ListTeamMembersWithOptions(it.ctx, it.teamID, it.opts)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.page = resp.Members
+		next := resp.Pagination.Next
+		if next == 0 || next == it.opts.Until || len(resp.Members) == 0 {
+			it.last = true
+		}
+		it.opts.Until = next
+	}
+
+	it.current, it.page = it.page[0], it.page[1:]
+
+	return true
+}
+
+// Member returns the current member.
+func (it *TeamMemberIterator) Member() TeamMember {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *TeamMemberIterator) Err() error {
+	return it.err
+}
+
+// InviteTeamMember invites a user to a team by email. The request is
+// validated before it is sent.
+func (c *Client) InviteTeamMember(ctx context.Context, teamID string, req InviteTeamMemberRequest) (*TeamInvite, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var invite TeamInvite
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/v1/teams/%s/members", teamID), nil, req, &invite); err != nil {
+		return nil, err
+	}
+
+	return &invite, nil
+}
+
+// UpdateTeamMember changes the role of a team member or confirms a pending
+// member. The request is validated before it is sent.
+func (c *Client) UpdateTeamMember(ctx context.Context, teamID, userID string, req UpdateTeamMemberRequest) error {
+	if err := validateTeamRole(req.Role); err != nil {
+		return err
+	}
+
+	return c.doRequest(ctx, "PATCH", fmt.Sprintf("/v1/teams/%s/members/%s", teamID, userID), nil, req, nil)
+}
+
+// RemoveTeamMember removes a member from a team, or declines their pending
+// access request.
+func (c *Client) RemoveTeamMember(ctx context.Context, teamID, userID string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v1/teams/%s/members/%s", teamID, userID), nil, nil, nil)
+}
+
+// ListTeamAccessRequests lists the members of a team that are waiting for
+// their invite or access request to be approved.
+func (c *Client) ListTeamAccessRequests(ctx context.Context, teamID string) ([]TeamMember, error) {
+	members, err := c.ListAllTeamMembers(ctx, teamID, ListTeamMembersOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []TeamMember
+	for _, m := range members {
+		if !m.Confirmed {
+			pending = append(pending, m)
+		}
+	}
+
+	return pending, nil
+}
+
+// ApproveTeamAccessRequest confirms a pending member with the given role.
+// An empty role keeps the role that was requested.
+func (c *Client) ApproveTeamAccessRequest(ctx context.Context, teamID, userID string, role TeamRole) error {
+	return c.UpdateTeamMember(ctx, teamID, userID, UpdateTeamMemberRequest{Role: role, Confirmed: true})
+}
+
+// Validate checks the email address and role of the invite.
+func (r InviteTeamMemberRequest) Validate() error {
+	if at := strings.LastIndex(r.Email, "@"); at < 1 || at == len(r.Email)-1 {
+		return &ValidationError{Field: "email", Message: fmt.Sprintf("%q is not an email address", r.Email)}
+	}
+
+	return validateTeamRole(r.Role)
+}
+
+// Validate checks the slug and policy settings of the request.
+func (r UpdateTeamRequest) Validate() error {
+	if r.Slug != "" {
+		if err := validateTeamSlug(r.Slug); err != nil {
+			return err
+		}
+	}
+
+	if p := r.SensitiveEnvironmentVariablePolicy; p != "" && p != "on" && p != "off" {
+		return &ValidationError{Field: "sensitiveEnvironmentVariablePolicy", Message: fmt.Sprintf("%q is not on or off", p)}
+	}
+	if p := r.EnablePreviewFeedback; p != "" && !teamPolicyValues[p] {
+		return &ValidationError{Field: "enablePreviewFeedback", Message: fmt.Sprintf("%q is not on, off or default", p)}
+	}
+
+	return nil
+}
+
+func validateTeamSlug(slug string) error {
+	if !teamSlugPattern.MatchString(slug) {
+		return &ValidationError{Field: "slug", Message: fmt.Sprintf("%q must be 1-48 lowercase letters, digits or hyphens", slug)}
+	}
+
+	return nil
+}
+
+func validateTeamRole(role TeamRole) error {
+	if role != "" && !teamRoles[role] {
+		return &ValidationError{Field: "role", Message: fmt.Sprintf("unknown team role %q", role)}
+	}
+
+	return nil
+}
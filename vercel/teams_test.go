@@ -69,7 +69,7 @@ func TestListTeamMembers_Success(t *testing.T) {
 						Username: "john",
 						Name:     "John Doe",
 					},
-					Role: "OWNER",
+					Role: TeamRoleOwner,
 				},
 			},
 		}
@@ -84,5 +84,206 @@ func TestListTeamMembers_Success(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, members.Members, 1)
 	assert.Equal(t, "john", members.Members[0].User.Username)
-	assert.Equal(t, "OWNER", members.Members[0].Role)
+	assert.Equal(t, TeamRoleOwner, members.Members[0].Role)
+}
+
+func TestInviteTeamMember_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/teams/team-1/members", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body InviteTeamMemberRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "jane@example.com", body.Email)
+		assert.Equal(t, TeamRoleDeveloper, body.Role)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(TeamInvite{UID: "user-2", Email: body.Email, Role: body.Role})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	invite, err := c.InviteTeamMember(context.Background(), "team-1", InviteTeamMemberRequest{
+		Email: "jane@example.com",
+		Role:  TeamRoleDeveloper,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "user-2", invite.UID)
+}
+
+func TestInviteTeamMember_InvalidRole(t *testing.T) {
+	c := New("test-token", WithBaseURL("http://unused"))
+
+	_, err := c.InviteTeamMember(context.Background(), "team-1", InviteTeamMemberRequest{
+		Email: "jane@example.com",
+		Role:  "ADMIN",
+	})
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "role", validationErr.Field)
+}
+
+func TestRemoveTeamMember_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/teams/team-1/members/user-2", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	require.NoError(t, c.RemoveTeamMember(context.Background(), "team-1", "user-2"))
+}
+
+func TestTeamAccessRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/teams/team-1/members":
+			w.Write([]byte(`{"members":[
+				{"user":{"id":"user-1","username":"john"},"role":"OWNER","confirmed":true},
+				{"user":{"id":"user-2","username":"jane"},"role":"MEMBER","confirmed":false,"accessRequestedAt":1700000000000}
+			]}`))
+		case "PATCH /v1/teams/team-1/members/user-2":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, map[string]interface{}{"role": "VIEWER", "confirmed": true}, body)
+			w.Write([]byte(`{"id":"user-2"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	pending, err := c.ListTeamAccessRequests(context.Background(), "team-1")
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "jane", pending[0].User.Username)
+
+	require.NoError(t, c.ApproveTeamAccessRequest(context.Background(), "team-1", "user-2", TeamRoleViewer))
+}
+
+func TestCreateTeam_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/teams", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body CreateTeamRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "sandbox-42", body.Slug)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Team{ID: "team-42", Slug: body.Slug})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	team, err := c.CreateTeam(context.Background(), CreateTeamRequest{Slug: "sandbox-42", Name: "Sandbox 42"})
+	require.NoError(t, err)
+	assert.Equal(t, "team-42", team.ID)
+
+	_, err = c.CreateTeam(context.Background(), CreateTeamRequest{Slug: "Not A Slug"})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "slug", validationErr.Field)
+}
+
+func TestUpdateTeam_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/teams/team-1", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{
+			"description":                        "Platform team",
+			"sensitiveEnvironmentVariablePolicy": "on",
+			"previewDeploymentSuffix":            "preview.example.com",
+		}, body)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id":"team-1","slug":"platform","description":"Platform team","sensitiveEnvironmentVariablePolicy":"on","membership":{"role":"OWNER","confirmed":true}}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	team, err := c.UpdateTeam(context.Background(), "team-1", UpdateTeamRequest{
+		Description:                        "Platform team",
+		SensitiveEnvironmentVariablePolicy: "on",
+		PreviewDeploymentSuffix:            "preview.example.com",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "on", team.SensitiveEnvironmentVariablePolicy)
+	require.NotNil(t, team.Membership)
+	assert.Equal(t, TeamRoleOwner, team.Membership.Role)
+}
+
+func TestDeleteTeam_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/teams/team-42", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	require.NoError(t, c.DeleteTeam(context.Background(), "team-42"))
+}
+
+func TestIterateTeamMembers(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/v2/teams/team-1/members", r.URL.Path)
+		assert.Equal(t, "DEVELOPER", r.URL.Query().Get("role"))
+		assert.Equal(t, "jane", r.URL.Query().Get("search"))
+		assert.Equal(t, "prj-1", r.URL.Query().Get("excludeProject"))
+
+		switch r.URL.Query().Get("until") {
+		case "":
+			w.Write([]byte(`{"members":[{"user":{"id":"user-1"}},{"user":{"id":"user-2"}}],"pagination":{"hasNext":true,"count":2,"next":1700000000000}}`))
+		case "1700000000000":
+			w.Write([]byte(`{"members":[{"user":{"id":"user-3"}}],"pagination":{"hasNext":false,"count":1,"next":null}}`))
+		default:
+			t.Errorf("unexpected until %q", r.URL.Query().Get("until"))
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	opts := ListTeamMembersOptions{Role: TeamRoleDeveloper, Search: "jane", ExcludeProject: "prj-1"}
+	it := c.IterateTeamMembers(context.Background(), "team-1", opts)
+
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Member().User.ID)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"user-1", "user-2", "user-3"}, ids)
+	assert.Equal(t, 2, requests)
+}
+
+func TestIterateTeamMembers_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error":{"code":"forbidden","message":"Not authorized"}}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	members, err := c.ListAllTeamMembers(context.Background(), "team-1", ListTeamMembersOptions{})
+	assert.Empty(t, members)
+
+	apiErr, ok := IsAPIError(err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
 }
@@ -0,0 +1,179 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// DefaultTokenMaxAge is the age after which AuditAuthTokens reports a token
+// as stale when no MaxAge is given.
+const DefaultTokenMaxAge = 90 * 24 * time.Hour
+
+// TokenAuditOptions configures AuditAuthTokens.
+type TokenAuditOptions struct {
+	// MaxAge is the age after which a token is reported as stale.
+	// Defaults to DefaultTokenMaxAge.
+	MaxAge time.Duration
+	// Leaked lists the IDs of tokens known to be leaked. They are revoked.
+	Leaked []string
+	// DryRun reports leaked tokens without revoking them.
+	DryRun bool
+}
+
+// TokenAuditReport reports the outcome of AuditAuthTokens.
+type TokenAuditReport struct {
+	Stale   []AuthToken // older than MaxAge
+	Expired []AuthToken // past their expiry
+	Leaked  []AuthToken
+	Revoked []AuthToken
+	// RevokeErrors maps token IDs to the error returned when revoking them.
+	RevokeErrors map[string]error
+}
+
+// ListAuthTokens lists one page of the auth tokens of the authenticated
+// user. Pass the previous response's Pagination.Next as until to fetch the
+// next page.
+func (c *Client) ListAuthTokens(ctx context.Context, limit int, until int64) (*ListAuthTokensResponse, error) {
+	query := make(map[string]string)
+	if limit > 0 {
+		query["limit"] = strconv.Itoa(limit)
+	}
+	if until > 0 {
+		query["until"] = strconv.FormatInt(until, 10)
+	}
+
+	var resp ListAuthTokensResponse
+	if err := c.doRequest(ctx, "GET", "/v5/user/tokens", query, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetAuthToken retrieves an auth token by ID. Pass "current" for the token
+// the client is using.
+func (c *Client) GetAuthToken(ctx context.Context, id string) (*AuthToken, error) {
+	var resp struct {
+		Token AuthToken `json:"token"`
+	}
+
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v5/user/tokens/%s", id), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Token, nil
+}
+
+// CreateAuthToken creates an auth token, optionally restricted to a team and
+// with an expiry. The bearer token is only returned by this call. The token
+// is restricted to req.TeamID only; the team ID of the client is not sent, so
+// a team-scoped client can still create unrestricted tokens.
+func (c *Client) CreateAuthToken(ctx context.Context, req CreateAuthTokenRequest) (*CreateAuthTokenResponse, error) {
+	if req.Name == "" {
+		return nil, &ValidationError{Field: "name", Message: "is required"}
+	}
+	if req.ExpiresAt != 0 && req.ExpiresAt <= time.Now().UnixMilli() {
+		return nil, &ValidationError{Field: "expiresAt", Message: "must be in the future"}
+	}
+
+	// teamId restricts the token, so it must come from the request alone.
+	uc := *c
+	uc.teamID = req.TeamID
+
+	var resp CreateAuthTokenResponse
+	if err := uc.doRequest(ctx, "POST", "/v3/user/tokens", nil, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeleteAuthToken revokes an auth token by ID.
+func (c *Client) DeleteAuthToken(ctx context.Context, id string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v3/user/tokens/%s", id), nil, nil, nil)
+}
+
+// AuditAuthTokens reviews every auth token of the authenticated user. Tokens
+// older than MaxAge and expired tokens are reported; tokens listed in Leaked
+// are revoked unless DryRun is set. The token the client is using is never
+// revoked.
+func (c *Client) AuditAuthTokens(ctx context.Context, opts TokenAuditOptions) (*TokenAuditReport, error) {
+	if opts.MaxAge <= 0 {
+		opts.MaxAge = DefaultTokenMaxAge
+	}
+
+	tokens, err := c.listAllAuthTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	leaked := make(map[string]bool)
+	for _, id := range opts.Leaked {
+		leaked[id] = true
+	}
+
+	var currentID string
+	if len(leaked) > 0 {
+		current, err := c.GetAuthToken(ctx, "current")
+		if err != nil {
+			return nil, fmt.Errorf("failed to identify the current token: %w", err)
+		}
+		currentID = current.ID
+	}
+
+	now := time.Now()
+	staleBefore := now.Add(-opts.MaxAge).UnixMilli()
+	report := &TokenAuditReport{RevokeErrors: make(map[string]error)}
+	for _, token := range tokens {
+		if token.CreatedAt < staleBefore {
+			report.Stale = append(report.Stale, token)
+		}
+		if token.ExpiresAt > 0 && token.ExpiresAt <= now.UnixMilli() {
+			report.Expired = append(report.Expired, token)
+		}
+		if !leaked[token.ID] {
+			continue
+		}
+
+		report.Leaked = append(report.Leaked, token)
+		if opts.DryRun {
+			continue
+		}
+		if token.ID == currentID {
+			report.RevokeErrors[token.ID] = fmt.Errorf("token %s is the token in use; revoke it manually", token.Name)
+			continue
+		}
+		if err := c.DeleteAuthToken(ctx, token.ID); err != nil {
+			report.RevokeErrors[token.ID] = err
+			continue
+		}
+		report.Revoked = append(report.Revoked, token)
+	}
+
+	sort.Slice(report.Stale, func(i, j int) bool {
+		return report.Stale[i].CreatedAt < report.Stale[j].CreatedAt
+	})
+
+	return report, nil
+}
+
+// listAllAuthTokens pages through ListAuthTokens and returns every token.
+func (c *Client) listAllAuthTokens(ctx context.Context) ([]AuthToken, error) {
+	var tokens []AuthToken
+	var until int64
+	for {
+		resp, err := c.ListAuthTokens(ctx, 100, until)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, resp.Tokens...)
+		if resp.Pagination.Next == 0 || resp.Pagination.Next == until || len(resp.Tokens) == 0 {
+			return tokens, nil
+		}
+		until = resp.Pagination.Next
+	}
+}
@@ -0,0 +1,114 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAuthToken_Success(t *testing.T) {
+	expiresAt := time.Now().Add(24 * time.Hour).UnixMilli()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/user/tokens", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "team-1", r.URL.Query().Get("teamId"))
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"name": "ci", "expiresAt": float64(expiresAt)}, body)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateAuthTokenResponse{
+			Token:       AuthToken{ID: "tok-1", Name: "ci", Scopes: []AuthTokenScope{{Type: "team", TeamID: "team-1"}}},
+			BearerToken: "secret",
+		})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	resp, err := c.CreateAuthToken(context.Background(), CreateAuthTokenRequest{Name: "ci", ExpiresAt: expiresAt, TeamID: "team-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "secret", resp.BearerToken)
+	assert.Equal(t, "team-1", resp.Token.Scopes[0].TeamID)
+}
+
+func TestCreateAuthToken_TeamScopedClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/user/tokens", r.URL.Path)
+		_, ok := r.URL.Query()["teamId"]
+		assert.False(t, ok, "teamId of the client must not restrict the token")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateAuthTokenResponse{Token: AuthToken{ID: "tok-1", Name: "ci"}, BearerToken: "secret"})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL), WithTeamID("team-client"))
+
+	resp, err := c.CreateAuthToken(context.Background(), CreateAuthTokenRequest{Name: "ci"})
+	require.NoError(t, err)
+	assert.Equal(t, "secret", resp.BearerToken)
+}
+
+func TestDeleteAuthToken_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/user/tokens/tok-1", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	require.NoError(t, c.DeleteAuthToken(context.Background(), "tok-1"))
+}
+
+func TestAuditAuthTokens(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-100 * 24 * time.Hour).UnixMilli()
+	recent := now.Add(-24 * time.Hour).UnixMilli()
+
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v5/user/tokens":
+			fmt.Fprintf(w, `{"tokens":[
+				{"id":"tok-1","name":"old","createdAt":%d},
+				{"id":"tok-2","name":"leaked","createdAt":%d},
+				{"id":"tok-3","name":"expired","createdAt":%d,"expiresAt":%d},
+				{"id":"tok-4","name":"current","createdAt":%d}
+			]}`, old, recent, recent, now.Add(-time.Hour).UnixMilli(), recent)
+		case "GET /v5/user/tokens/current":
+			w.Write([]byte(`{"token":{"id":"tok-4","name":"current"}}`))
+		case "DELETE /v3/user/tokens/tok-2":
+			deleted = append(deleted, "tok-2")
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	report, err := c.AuditAuthTokens(context.Background(), TokenAuditOptions{Leaked: []string{"tok-2", "tok-4"}})
+	require.NoError(t, err)
+
+	require.Len(t, report.Stale, 1)
+	assert.Equal(t, "tok-1", report.Stale[0].ID)
+	require.Len(t, report.Expired, 1)
+	assert.Equal(t, "tok-3", report.Expired[0].ID)
+	assert.Len(t, report.Leaked, 2)
+	require.Len(t, report.Revoked, 1)
+	assert.Equal(t, "tok-2", report.Revoked[0].ID)
+	assert.Contains(t, report.RevokeErrors, "tok-4")
+	assert.Equal(t, []string{"tok-2"}, deleted)
+}
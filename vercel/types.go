@@ -1,5 +1,10 @@
 package vercel
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // Project represents a Vercel project.
 type Project struct {
 	ID           string      `json:"id"`
@@ -30,6 +35,7 @@ type Deployment struct {
 	Name       string `json:"name"`
 	URL        string `json:"url"`
 	State      string `json:"state"`
+	ReadyState string `json:"readyState,omitempty"`
 	Target     string `json:"target"`
 	CreatedAt  int64  `json:"createdAt"`
 	ReadyAt    int64  `json:"readyAt,omitempty"`
@@ -49,27 +55,47 @@ type ListDeploymentsResponse struct {
 }
 
 // DeploymentFile represents a file in a deployment.
+// A file is either inlined through Data or references a previously
+// uploaded file through SHA and Size.
 type DeploymentFile struct {
-	File string `json:"file"` // path
-	Data string `json:"data"` // base64-encoded file
+	File     string `json:"file"`               // path
+	Data     string `json:"data,omitempty"`     // base64-encoded file
+	Encoding string `json:"encoding,omitempty"` // "base64" or "utf-8"
+	SHA      string `json:"sha,omitempty"`      // SHA1 of an uploaded file
+	Size     int64  `json:"size,omitempty"`
+	Mode     uint32 `json:"mode,omitempty"` // unix file mode
+}
+
+// DeploymentFileTree represents a file or directory in a deployment's file tree.
+type DeploymentFileTree struct {
+	Name        string               `json:"name"`
+	Type        string               `json:"type"`          // "directory", "file", "symlink", "lambda", "middleware" or "invalid"
+	UID         string               `json:"uid,omitempty"` // SHA1 of the file contents
+	Children    []DeploymentFileTree `json:"children,omitempty"`
+	ContentType string               `json:"contentType,omitempty"`
+	Mode        uint32               `json:"mode"`
+	Symlink     string               `json:"symlink,omitempty"`
 }
 
 // CreateDeploymentRequest represents a request to create a deployment.
 type CreateDeploymentRequest struct {
-	Name    string            `json:"name"`
-	Project string            `json:"project,omitempty"`
-	Target  string            `json:"target,omitempty"` // "production" or "staging"
-	Files   []DeploymentFile  `json:"files,omitempty"`
-	Env     map[string]string `json:"env,omitempty"`
+	Name     string            `json:"name"`
+	Project  string            `json:"project,omitempty"`
+	Target   string            `json:"target,omitempty"` // "production" or "staging"
+	Files    []DeploymentFile  `json:"files,omitempty"`
+	Env      map[string]string `json:"env,omitempty"`
+	Prebuilt bool              `json:"prebuilt,omitempty"` // files are a Build Output API directory
 }
 
 // EnvType represents the type of an environment variable.
 type EnvType string
 
 const (
-	EnvTypePlain  EnvType = "plain"
-	EnvTypeSecret EnvType = "secret"
-	EnvTypeSystem EnvType = "system"
+	EnvTypePlain     EnvType = "plain"
+	EnvTypeEncrypted EnvType = "encrypted"
+	EnvTypeSensitive EnvType = "sensitive" // value can never be read back
+	EnvTypeSecret    EnvType = "secret"    // references a legacy secret
+	EnvTypeSystem    EnvType = "system"
 )
 
 // EnvTarget represents the target environment for an environment variable.
@@ -83,48 +109,262 @@ const (
 
 // EnvVar represents a Vercel environment variable.
 type EnvVar struct {
-	ID        string      `json:"id"`
-	Key       string      `json:"key"`
-	Value     string      `json:"value,omitempty"`
-	Type      EnvType     `json:"type"`
-	Target    []EnvTarget `json:"target"`
-	CreatedAt int64       `json:"createdAt,omitempty"`
-	UpdatedAt int64       `json:"updatedAt,omitempty"`
+	ID                   string      `json:"id"`
+	Key                  string      `json:"key"`
+	Value                string      `json:"value,omitempty"`
+	Type                 EnvType     `json:"type"`
+	Target               []EnvTarget `json:"target"`
+	GitBranch            string      `json:"gitBranch,omitempty"`
+	Comment              string      `json:"comment,omitempty"`
+	ConfigurationID      string      `json:"configurationId,omitempty"`
+	CustomEnvironmentIDs []string    `json:"customEnvironmentIds,omitempty"`
+	Decrypted            bool        `json:"decrypted,omitempty"` // Value holds the plaintext
+	CreatedBy            string      `json:"createdBy,omitempty"`
+	UpdatedBy            string      `json:"updatedBy,omitempty"`
+	CreatedAt            int64       `json:"createdAt,omitempty"`
+	UpdatedAt            int64       `json:"updatedAt,omitempty"`
+}
+
+// ListEnvVarsOptions represents options for listing environment variables.
+type ListEnvVarsOptions struct {
+	Decrypt   bool   // return decrypted values where the type allows it
+	GitBranch string // only return variables applying to this git branch
 }
 
 // CreateEnvVarRequest represents a request to create an environment variable.
 type CreateEnvVarRequest struct {
-	Key    string      `json:"key"`
-	Value  string      `json:"value"`
-	Type   EnvType     `json:"type"`
-	Target []EnvTarget `json:"target"`
+	Key                  string      `json:"key"`
+	Value                string      `json:"value"`
+	Type                 EnvType     `json:"type"`
+	Target               []EnvTarget `json:"target,omitempty"`
+	GitBranch            string      `json:"gitBranch,omitempty"` // only with the preview target
+	Comment              string      `json:"comment,omitempty"`
+	CustomEnvironmentIDs []string    `json:"customEnvironmentIds,omitempty"`
 }
 
 // Domain represents a Vercel domain.
 type Domain struct {
-	ID           string   `json:"id"`
-	Name         string   `json:"name"`
-	ServiceType  string   `json:"serviceType,omitempty"`
-	Nameservers  []string `json:"nameservers,omitempty"`
-	Intent       string   `json:"intent,omitempty"`
-	CreatedAt    int64    `json:"createdAt,omitempty"`
-	UpdatedAt    int64    `json:"updatedAt,omitempty"`
-	Verified     bool     `json:"verified,omitempty"`
-	Verification []struct {
-		Type   string `json:"type"`
-		Domain string `json:"domain"`
-		Value  string `json:"value"`
-	} `json:"verification,omitempty"`
-	ConfigVerifiedAt int64  `json:"configVerifiedAt,omitempty"`
-	CDNEnabled       bool   `json:"cdnEnabled,omitempty"`
-	GitBranch        string `json:"gitBranch,omitempty"`
-	ProjectID        string `json:"projectId,omitempty"`
+	ID                 string               `json:"id"`
+	Name               string               `json:"name"`
+	ServiceType        string               `json:"serviceType,omitempty"`
+	Nameservers        []string             `json:"nameservers,omitempty"`
+	Intent             string               `json:"intent,omitempty"`
+	CreatedAt          int64                `json:"createdAt,omitempty"`
+	UpdatedAt          int64                `json:"updatedAt,omitempty"`
+	Verified           bool                 `json:"verified,omitempty"`
+	Verification       []DomainVerification `json:"verification,omitempty"`
+	ConfigVerifiedAt   int64                `json:"configVerifiedAt,omitempty"`
+	CDNEnabled         bool                 `json:"cdnEnabled,omitempty"`
+	GitBranch          string               `json:"gitBranch,omitempty"`
+	ProjectID          string               `json:"projectId,omitempty"`
+	ApexName           string               `json:"apexName,omitempty"`
+	Redirect           string               `json:"redirect,omitempty"`
+	RedirectStatusCode int                  `json:"redirectStatusCode,omitempty"`
+}
+
+// DomainVerification is a challenge that must be satisfied to verify
+// ownership of a project domain, typically a TXT record.
+type DomainVerification struct {
+	Type   string `json:"type"`
+	Domain string `json:"domain"`
+	Value  string `json:"value"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// DomainConfig represents the DNS configuration status of a domain.
+type DomainConfig struct {
+	ConfiguredBy       string   `json:"configuredBy,omitempty"` // "CNAME", "A", "http" or "dns-01"; empty when not configured
+	AcceptedChallenges []string `json:"acceptedChallenges,omitempty"`
+	Misconfigured      bool     `json:"misconfigured"`
+	ServiceType        string   `json:"serviceType,omitempty"`
+	Nameservers        []string `json:"nameservers,omitempty"`
+	CNAMEs             []string `json:"cnames,omitempty"`
+	AValues            []string `json:"aValues,omitempty"`
+	RecommendedIPv4    []struct {
+		Rank  int      `json:"rank"`
+		Value []string `json:"value"`
+	} `json:"recommendedIPv4,omitempty"`
+	RecommendedCNAME []struct {
+		Rank  int    `json:"rank"`
+		Value string `json:"value"`
+	} `json:"recommendedCNAME,omitempty"`
 }
 
 // CreateDomainRequest represents a request to create/add a domain to a project.
 type CreateDomainRequest struct {
-	Name      string `json:"name"`
-	GitBranch string `json:"gitBranch,omitempty"`
+	Name               string `json:"name"`
+	GitBranch          string `json:"gitBranch,omitempty"`
+	Redirect           string `json:"redirect,omitempty"`
+	RedirectStatusCode int    `json:"redirectStatusCode,omitempty"` // 301, 302, 307 or 308
+}
+
+// UpdateProjectDomainRequest represents a request to update a project
+// domain. Nil fields are left unchanged; a pointer to an empty string
+// removes the redirect or git branch.
+type UpdateProjectDomainRequest struct {
+	GitBranch          *string `json:"gitBranch,omitempty"`
+	Redirect           *string `json:"redirect,omitempty"`
+	RedirectStatusCode *int    `json:"redirectStatusCode,omitempty"` // 301, 302, 307 or 308
+}
+
+// AccountDomain represents a domain registered to the authenticated user or team.
+type AccountDomain struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	ServiceType         string   `json:"serviceType,omitempty"` // "zeit.world", "external" or "na"
+	Nameservers         []string `json:"nameservers,omitempty"`
+	IntendedNameservers []string `json:"intendedNameservers,omitempty"`
+	CustomNameservers   []string `json:"customNameservers,omitempty"`
+	Verified            bool     `json:"verified"`
+	Zone                bool     `json:"zone,omitempty"`
+	Renew               bool     `json:"renew,omitempty"`
+	TeamID              string   `json:"teamId,omitempty"`
+	UserID              string   `json:"userId,omitempty"`
+	Creator             *struct {
+		ID       string `json:"id"`
+		Username string `json:"username,omitempty"`
+		Email    string `json:"email,omitempty"`
+	} `json:"creator,omitempty"`
+	BoughtAt          int64 `json:"boughtAt,omitempty"`
+	CreatedAt         int64 `json:"createdAt,omitempty"`
+	ExpiresAt         int64 `json:"expiresAt,omitempty"`
+	OrderedAt         int64 `json:"orderedAt,omitempty"`
+	TransferredAt     int64 `json:"transferredAt,omitempty"`
+	TransferStartedAt int64 `json:"transferStartedAt,omitempty"`
+}
+
+// ListAccountDomainsResponse represents the response from listing account domains.
+type ListAccountDomainsResponse struct {
+	Domains    []AccountDomain `json:"domains"`
+	Pagination struct {
+		Count int   `json:"count"`
+		Next  int64 `json:"next,omitempty"`
+		Prev  int64 `json:"prev,omitempty"`
+	} `json:"pagination"`
+}
+
+// AddAccountDomainRequest represents a request to add an external domain.
+type AddAccountDomainRequest struct {
+	Name       string `json:"name"`
+	CDNEnabled bool   `json:"cdnEnabled,omitempty"`
+	Zone       bool   `json:"zone,omitempty"`
+}
+
+// DomainAvailability represents whether a domain can be purchased.
+type DomainAvailability struct {
+	Available bool `json:"available"`
+}
+
+// DomainPrice represents the purchase price of a domain.
+type DomainPrice struct {
+	Price  float64 `json:"price"`
+	Period int     `json:"period"` // years
+}
+
+// DNSRecordType represents the type of a DNS record.
+type DNSRecordType string
+
+const (
+	DNSRecordA     DNSRecordType = "A"
+	DNSRecordAAAA  DNSRecordType = "AAAA"
+	DNSRecordCNAME DNSRecordType = "CNAME"
+	DNSRecordMX    DNSRecordType = "MX"
+	DNSRecordTXT   DNSRecordType = "TXT"
+	DNSRecordSRV   DNSRecordType = "SRV"
+	DNSRecordCAA   DNSRecordType = "CAA"
+	DNSRecordNS    DNSRecordType = "NS"
+)
+
+// DNSRecord represents a DNS record of a Vercel-managed domain.
+type DNSRecord struct {
+	ID         string        `json:"id"`
+	Slug       string        `json:"slug,omitempty"`
+	Name       string        `json:"name"` // relative to the domain; empty for the apex
+	Type       DNSRecordType `json:"type"`
+	Value      string        `json:"value"` // for SRV: "weight port target"
+	MXPriority int           `json:"mxPriority,omitempty"`
+	Priority   int           `json:"priority,omitempty"` // SRV priority
+	TTL        int           `json:"ttl,omitempty"`
+	Comment    string        `json:"comment,omitempty"`
+	Creator    string        `json:"creator,omitempty"` // "system" for records managed by Vercel
+	CreatedAt  int64         `json:"createdAt,omitempty"`
+	UpdatedAt  int64         `json:"updatedAt,omitempty"`
+}
+
+// ListDNSRecordsResponse represents the response from listing DNS records.
+type ListDNSRecordsResponse struct {
+	Records    []DNSRecord `json:"records"`
+	Pagination struct {
+		Count int   `json:"count"`
+		Next  int64 `json:"next,omitempty"`
+		Prev  int64 `json:"prev,omitempty"`
+	} `json:"pagination"`
+}
+
+// SRVRecord holds the fields of an SRV record.
+type SRVRecord struct {
+	Priority int    `json:"priority"`
+	Weight   int    `json:"weight"`
+	Port     int    `json:"port"`
+	Target   string `json:"target"`
+}
+
+// CreateDNSRecordRequest represents a request to create a DNS record.
+// Value is unused for SRV records, which are described by SRV instead.
+type CreateDNSRecordRequest struct {
+	Name       string        `json:"name"` // relative to the domain; empty or "@" for the apex
+	Type       DNSRecordType `json:"type"`
+	Value      string        `json:"value,omitempty"`
+	TTL        int           `json:"ttl,omitempty"`
+	MXPriority int           `json:"mxPriority,omitempty"`
+	SRV        *SRVRecord    `json:"srv,omitempty"`
+	Comment    string        `json:"comment,omitempty"`
+}
+
+// CreateDNSRecordResponse represents the response from creating a DNS record.
+type CreateDNSRecordResponse struct {
+	UID     string `json:"uid"`
+	Updated int64  `json:"updated,omitempty"`
+}
+
+// UpdateDNSRecordRequest represents a request to update a DNS record.
+// Nil and empty fields are left unchanged.
+type UpdateDNSRecordRequest struct {
+	Name       *string       `json:"name,omitempty"`
+	Type       DNSRecordType `json:"type,omitempty"`
+	Value      string        `json:"value,omitempty"`
+	TTL        int           `json:"ttl,omitempty"`
+	MXPriority *int          `json:"mxPriority,omitempty"`
+	SRV        *SRVRecord    `json:"srv,omitempty"`
+	Comment    string        `json:"comment,omitempty"`
+}
+
+// Cert represents an SSL certificate.
+type Cert struct {
+	ID        string   `json:"id"`
+	CNs       []string `json:"cns"`
+	AutoRenew bool     `json:"autoRenew"`
+	CreatedAt int64    `json:"createdAt,omitempty"`
+	ExpiresAt int64    `json:"expiresAt,omitempty"`
+}
+
+// ListCertsResponse represents the response from listing certificates.
+type ListCertsResponse struct {
+	Certs      []Cert `json:"certs"`
+	Pagination struct {
+		Count int   `json:"count"`
+		Next  int64 `json:"next,omitempty"`
+		Prev  int64 `json:"prev,omitempty"`
+	} `json:"pagination"`
+}
+
+// UploadCertRequest represents a request to upload a certificate. All
+// fields are PEM encoded.
+type UploadCertRequest struct {
+	Cert           string `json:"cert"`
+	Key            string `json:"key"`
+	CA             string `json:"ca"`
+	SkipValidation bool   `json:"skipValidation,omitempty"`
 }
 
 // UpdateProjectRequest represents a request to update a project.
@@ -143,11 +383,151 @@ type UpdateProjectRequest struct {
 	} `json:"gitRepository,omitempty"`
 }
 
+// ProjectRole represents the role of a member within a project.
+type ProjectRole string
+
+const (
+	ProjectRoleAdmin     ProjectRole = "ADMIN"
+	ProjectRoleDeveloper ProjectRole = "PROJECT_DEVELOPER"
+	ProjectRoleViewer    ProjectRole = "PROJECT_VIEWER"
+)
+
+// ProjectMember represents a member of a project.
+type ProjectMember struct {
+	UID                 string      `json:"uid"`
+	Username            string      `json:"username"`
+	Name                string      `json:"name,omitempty"`
+	Email               string      `json:"email"`
+	Avatar              string      `json:"avatar,omitempty"`
+	Role                ProjectRole `json:"role"`
+	ComputedProjectRole ProjectRole `json:"computedProjectRole,omitempty"` // effective role, including team and access group grants
+	TeamRole            TeamRole    `json:"teamRole,omitempty"`
+	CreatedAt           int64       `json:"createdAt,omitempty"`
+}
+
+// ListProjectMembersResponse represents the response from listing project members.
+type ListProjectMembersResponse struct {
+	Members    []ProjectMember `json:"members"`
+	Pagination struct {
+		HasNext bool  `json:"hasNext"`
+		Count   int   `json:"count"`
+		Next    int64 `json:"next,omitempty"`
+		Prev    int64 `json:"prev,omitempty"`
+	} `json:"pagination"`
+}
+
+// AddProjectMemberRequest represents a request to add a team member to a
+// project. Exactly one of UID, Username or Email identifies the member.
+type AddProjectMemberRequest struct {
+	UID      string      `json:"uid,omitempty"`
+	Username string      `json:"username,omitempty"`
+	Email    string      `json:"email,omitempty"`
+	Role     ProjectRole `json:"role"`
+}
+
+// AccessGroup represents a team access group.
+type AccessGroup struct {
+	ID             string     `json:"accessGroupId"`
+	Name           string     `json:"name"`
+	TeamID         string     `json:"teamId,omitempty"`
+	MembersCount   int        `json:"membersCount"`
+	ProjectsCount  int        `json:"projectsCount"`
+	TeamRoles      []TeamRole `json:"teamRoles,omitempty"`
+	IsDsyncManaged bool       `json:"isDsyncManaged,omitempty"` // managed by directory sync
+	CreatedAt      string     `json:"createdAt,omitempty"`
+	UpdatedAt      string     `json:"updatedAt,omitempty"`
+}
+
+// AccessGroupMember represents a member of an access group.
+type AccessGroupMember struct {
+	UID       string   `json:"uid"`
+	Username  string   `json:"username"`
+	Name      string   `json:"name,omitempty"`
+	Email     string   `json:"email"`
+	Avatar    string   `json:"avatar,omitempty"`
+	TeamRole  TeamRole `json:"teamRole,omitempty"`
+	CreatedAt string   `json:"createdAt,omitempty"`
+}
+
+// AccessGroupProject represents a project an access group grants a role on.
+type AccessGroupProject struct {
+	ProjectID string      `json:"projectId"`
+	Role      ProjectRole `json:"role"`
+	Project   *struct {
+		Name               string `json:"name,omitempty"`
+		Framework          string `json:"framework,omitempty"`
+		LatestDeploymentID string `json:"latestDeploymentId,omitempty"`
+	} `json:"project,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+// AccessGroupPagination is the cursor-based pagination of access group
+// listings. Next is empty on the last page.
+type AccessGroupPagination struct {
+	Count int    `json:"count"`
+	Next  string `json:"next,omitempty"`
+}
+
+// ListAccessGroupsResponse represents the response from listing access groups.
+type ListAccessGroupsResponse struct {
+	AccessGroups []AccessGroup         `json:"accessGroups"`
+	Pagination   AccessGroupPagination `json:"pagination"`
+}
+
+// ListAccessGroupMembersResponse represents the response from listing the
+// members of an access group.
+type ListAccessGroupMembersResponse struct {
+	Members    []AccessGroupMember   `json:"members"`
+	Pagination AccessGroupPagination `json:"pagination"`
+}
+
+// ListAccessGroupProjectsResponse represents the response from listing the
+// projects of an access group.
+type ListAccessGroupProjectsResponse struct {
+	Projects   []AccessGroupProject  `json:"projects"`
+	Pagination AccessGroupPagination `json:"pagination"`
+}
+
+// ListAccessGroupsOptions filters and pages access group listings.
+type ListAccessGroupsOptions struct {
+	ProjectID string // only groups with access to this project
+	Search    string
+	Limit     int
+	Next      string // the previous response's Pagination.Next
+}
+
+// AccessGroupProjectRole grants an access group a role on a project.
+type AccessGroupProjectRole struct {
+	ProjectID string      `json:"projectId"`
+	Role      ProjectRole `json:"role"`
+}
+
+// CreateAccessGroupRequest represents a request to create an access group.
+type CreateAccessGroupRequest struct {
+	Name         string                   `json:"name"`
+	Projects     []AccessGroupProjectRole `json:"projects,omitempty"`
+	MembersToAdd []string                 `json:"membersToAdd,omitempty"` // user IDs
+}
+
+// UpdateAccessGroupRequest represents a request to update an access group.
+// Empty fields are left unchanged.
+type UpdateAccessGroupRequest struct {
+	Name            string                   `json:"name,omitempty"`
+	Projects        []AccessGroupProjectRole `json:"projects,omitempty"`
+	MembersToAdd    []string                 `json:"membersToAdd,omitempty"`    // user IDs
+	MembersToRemove []string                 `json:"membersToRemove,omitempty"` // user IDs
+}
+
 // UpdateEnvVarRequest represents a request to update an environment variable.
 type UpdateEnvVarRequest struct {
-	Value     string      `json:"value,omitempty"`
-	Target    []EnvTarget `json:"target,omitempty"`
-	GitBranch string      `json:"gitBranch,omitempty"`
+	Key                  string      `json:"key,omitempty"`
+	Value                string      `json:"value,omitempty"`
+	Type                 EnvType     `json:"type,omitempty"`
+	Target               []EnvTarget `json:"target,omitempty"`
+	GitBranch            string      `json:"gitBranch,omitempty"`
+	Comment              string      `json:"comment,omitempty"`
+	CustomEnvironmentIDs []string    `json:"customEnvironmentIds,omitempty"`
 }
 
 // DeploymentLog represents a log entry from a deployment.
@@ -165,15 +545,63 @@ type DeploymentLogsResponse struct {
 
 // Team represents a Vercel team.
 type Team struct {
-	ID         string `json:"id"`
-	Name       string `json:"name"`
-	Slug       string `json:"slug"`
-	Avatar     string `json:"avatar,omitempty"`
-	CreatedAt  int64  `json:"createdAt,omitempty"`
-	UpdatedAt  int64  `json:"updatedAt,omitempty"`
-	Membership *struct {
-		Role string `json:"role"`
-	} `json:"membership,omitempty"`
+	ID                                 string          `json:"id"`
+	Name                               string          `json:"name"`
+	Slug                               string          `json:"slug"`
+	Avatar                             string          `json:"avatar,omitempty"`
+	Description                        string          `json:"description,omitempty"`
+	CreatorID                          string          `json:"creatorId,omitempty"`
+	CreatedAt                          int64           `json:"createdAt,omitempty"`
+	UpdatedAt                          int64           `json:"updatedAt,omitempty"`
+	StagingPrefix                      string          `json:"stagingPrefix,omitempty"`
+	PreviewDeploymentSuffix            string          `json:"previewDeploymentSuffix,omitempty"`
+	SensitiveEnvironmentVariablePolicy string          `json:"sensitiveEnvironmentVariablePolicy,omitempty"` // "on", "off" or "default"
+	EnablePreviewFeedback              string          `json:"enablePreviewFeedback,omitempty"`              // "on", "off" or "default"
+	HideIPAddresses                    bool            `json:"hideIpAddresses,omitempty"`
+	EmailDomain                        string          `json:"emailDomain,omitempty"`
+	InviteCode                         string          `json:"inviteCode,omitempty"`
+	RemoteCaching                      *RemoteCaching  `json:"remoteCaching,omitempty"`
+	Membership                         *TeamMembership `json:"membership,omitempty"`
+}
+
+// TeamMembership describes the authenticated user's membership of a team.
+type TeamMembership struct {
+	UID               string   `json:"uid,omitempty"`
+	TeamID            string   `json:"teamId,omitempty"`
+	Role              TeamRole `json:"role"`
+	Confirmed         bool     `json:"confirmed"`
+	AccessRequestedAt int64    `json:"accessRequestedAt,omitempty"`
+	CreatedAt         int64    `json:"createdAt,omitempty"`
+	JoinedFrom        *struct {
+		Origin string `json:"origin"`
+	} `json:"joinedFrom,omitempty"`
+}
+
+// RemoteCaching represents the remote caching settings of a team.
+type RemoteCaching struct {
+	Enabled bool `json:"enabled"`
+}
+
+// CreateTeamRequest represents a request to create a team.
+type CreateTeamRequest struct {
+	Slug string `json:"slug"`
+	Name string `json:"name,omitempty"`
+}
+
+// UpdateTeamRequest represents a request to update a team. Empty fields
+// are left unchanged.
+type UpdateTeamRequest struct {
+	Name                               string         `json:"name,omitempty"`
+	Slug                               string         `json:"slug,omitempty"`
+	Description                        string         `json:"description,omitempty"`
+	Avatar                             string         `json:"avatar,omitempty"` // SHA of an uploaded image
+	PreviewDeploymentSuffix            string         `json:"previewDeploymentSuffix,omitempty"`
+	SensitiveEnvironmentVariablePolicy string         `json:"sensitiveEnvironmentVariablePolicy,omitempty"` // "on" or "off"
+	EnablePreviewFeedback              string         `json:"enablePreviewFeedback,omitempty"`              // "on", "off" or "default"
+	HideIPAddresses                    *bool          `json:"hideIpAddresses,omitempty"`
+	EmailDomain                        string         `json:"emailDomain,omitempty"`
+	RemoteCaching                      *RemoteCaching `json:"remoteCaching,omitempty"`
+	RegenerateInviteCode               bool           `json:"regenerateInviteCode,omitempty"`
 }
 
 // ListTeamsResponse represents the response from listing teams.
@@ -181,6 +609,17 @@ type ListTeamsResponse struct {
 	Teams []Team `json:"teams"`
 }
 
+// TeamRole represents the role of a member within a team.
+type TeamRole string
+
+const (
+	TeamRoleOwner     TeamRole = "OWNER"
+	TeamRoleMember    TeamRole = "MEMBER"
+	TeamRoleDeveloper TeamRole = "DEVELOPER"
+	TeamRoleViewer    TeamRole = "VIEWER"
+	TeamRoleBilling   TeamRole = "BILLING"
+)
+
 // TeamMember represents a member of a team.
 type TeamMember struct {
 	User struct {
@@ -190,13 +629,178 @@ type TeamMember struct {
 		Email    string `json:"email,omitempty"`
 		Avatar   string `json:"avatar,omitempty"`
 	} `json:"user"`
-	Role      string `json:"role"`
-	CreatedAt int64  `json:"createdAt,omitempty"`
+	Role              TeamRole `json:"role"`
+	Confirmed         bool     `json:"confirmed"` // false while an invite or access request is pending
+	AccessRequestedAt int64    `json:"accessRequestedAt,omitempty"`
+	CreatedAt         int64    `json:"createdAt,omitempty"`
+}
+
+// InviteTeamMemberRequest represents a request to invite a user to a team.
+type InviteTeamMemberRequest struct {
+	Email string   `json:"email"`
+	Role  TeamRole `json:"role,omitempty"` // defaults to MEMBER
+}
+
+// TeamInvite represents the response from inviting a team member.
+type TeamInvite struct {
+	UID      string   `json:"uid"`
+	Username string   `json:"username"`
+	Email    string   `json:"email"`
+	Role     TeamRole `json:"role"`
+}
+
+// UpdateTeamMemberRequest represents a request to update a team member.
+type UpdateTeamMemberRequest struct {
+	Role TeamRole `json:"role,omitempty"`
+	// Confirmed approves a pending access request. It cannot be unset.
+	Confirmed bool `json:"confirmed,omitempty"`
 }
 
 // ListTeamMembersResponse represents the response from listing team members.
 type ListTeamMembersResponse struct {
-	Members []TeamMember `json:"members"`
+	Members    []TeamMember `json:"members"`
+	Pagination struct {
+		HasNext bool  `json:"hasNext"`
+		Count   int   `json:"count"`
+		Next    int64 `json:"next,omitempty"`
+		Prev    int64 `json:"prev,omitempty"`
+	} `json:"pagination"`
+}
+
+// ListTeamMembersOptions filters and pages team member listings.
+type ListTeamMembersOptions struct {
+	Limit int
+	// Until returns members that joined before this timestamp; pass the
+	// previous response's Pagination.Next to fetch the next page.
+	Until int64
+	Role  TeamRole
+	// Search matches the name, email address or username of members.
+	Search string
+	// EligibleMembersForProjectID only includes members that can be added to
+	// the project.
+	EligibleMembersForProjectID string
+	// ExcludeProject excludes members of the project.
+	ExcludeProject string
+}
+
+// User represents the authenticated user.
+type User struct {
+	ID            string `json:"id"`
+	Email         string `json:"email"`
+	Name          string `json:"name,omitempty"`
+	Username      string `json:"username"`
+	Avatar        string `json:"avatar,omitempty"`
+	DefaultTeamID string `json:"defaultTeamId,omitempty"`
+	CreatedAt     int64  `json:"createdAt,omitempty"`
+}
+
+// AuthTokenScope describes what an auth token can access.
+type AuthTokenScope struct {
+	Type      string `json:"type"` // "user" or "team"
+	TeamID    string `json:"teamId,omitempty"`
+	Origin    string `json:"origin,omitempty"`
+	CreatedAt int64  `json:"createdAt,omitempty"`
+	ExpiresAt int64  `json:"expiresAt,omitempty"`
+}
+
+// AuthToken represents an auth token of the authenticated user. The token
+// value itself is only returned when it is created.
+type AuthToken struct {
+	ID        string           `json:"id"`
+	Name      string           `json:"name"`
+	Type      string           `json:"type"`
+	Origin    string           `json:"origin,omitempty"`
+	Scopes    []AuthTokenScope `json:"scopes,omitempty"`
+	ExpiresAt int64            `json:"expiresAt,omitempty"`
+	ActiveAt  int64            `json:"activeAt,omitempty"`
+	CreatedAt int64            `json:"createdAt"`
+}
+
+// ListAuthTokensResponse represents the response from listing auth tokens.
+type ListAuthTokensResponse struct {
+	Tokens     []AuthToken `json:"tokens"`
+	Pagination struct {
+		Count int   `json:"count"`
+		Next  int64 `json:"next,omitempty"`
+		Prev  int64 `json:"prev,omitempty"`
+	} `json:"pagination"`
+}
+
+// CreateAuthTokenRequest represents a request to create an auth token.
+type CreateAuthTokenRequest struct {
+	Name      string `json:"name"`
+	ExpiresAt int64  `json:"expiresAt,omitempty"` // milliseconds since the epoch
+	// TeamID restricts the token to a single team.
+	TeamID string `json:"-"`
+}
+
+// CreateAuthTokenResponse represents the response from creating an auth
+// token. BearerToken is only ever returned here.
+type CreateAuthTokenResponse struct {
+	Token       AuthToken `json:"token"`
+	BearerToken string    `json:"bearerToken"`
+}
+
+// Event represents an entry in the user or team events feed.
+type Event struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	Text        string `json:"text"`
+	CreatedAt   int64  `json:"createdAt"`
+	UserID      string `json:"userId,omitempty"`
+	PrincipalID string `json:"principalId,omitempty"` // the user or integration that acted
+	User        *struct {
+		UID      string `json:"uid"`
+		Username string `json:"username"`
+		Email    string `json:"email,omitempty"`
+		Avatar   string `json:"avatar,omitempty"`
+	} `json:"user,omitempty"`
+	Entities []EventEntity   `json:"entities,omitempty"`
+	Payload  json.RawMessage `json:"payload,omitempty"`
+}
+
+// EventEntity marks a span of Event.Text that refers to an object.
+type EventEntity struct {
+	Type  string `json:"type"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
+
+// DeploymentEventPayload is the payload of deployment events.
+type DeploymentEventPayload struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	URL       string `json:"url,omitempty"`
+	Target    string `json:"target,omitempty"`
+	ProjectID string `json:"projectId,omitempty"`
+}
+
+// EnvVarEventPayload is the payload of environment variable events. Values
+// are never included.
+type EnvVarEventPayload struct {
+	ID          string      `json:"id,omitempty"`
+	Key         string      `json:"key,omitempty"`
+	Type        EnvType     `json:"type,omitempty"`
+	Target      []EnvTarget `json:"target,omitempty"`
+	ProjectID   string      `json:"projectId,omitempty"`
+	ProjectName string      `json:"projectName,omitempty"`
+}
+
+// DomainEventPayload is the payload of domain events.
+type DomainEventPayload struct {
+	Name      string `json:"name,omitempty"`
+	ProjectID string `json:"projectId,omitempty"`
+}
+
+// ListEventsOptions filters event listings. Zero values are ignored.
+type ListEventsOptions struct {
+	Limit       int
+	Since       time.Time
+	Until       time.Time
+	Types       []string
+	UserID      string
+	PrincipalID string
+	ProjectIDs  []string
 }
 
 // Alias represents a Vercel alias.
@@ -207,12 +811,13 @@ type Alias struct {
 		ID  string `json:"id"`
 		URL string `json:"url"`
 	} `json:"deployment,omitempty"`
-	ProjectID string  `json:"projectId,omitempty"`
-	Domain    string  `json:"domain,omitempty"`
-	Target    string  `json:"target,omitempty"`
-	Redirect  *string `json:"redirect,omitempty"`
-	CreatedAt int64   `json:"createdAt,omitempty"`
-	UpdatedAt int64   `json:"updatedAt,omitempty"`
+	DeploymentID string  `json:"deploymentId,omitempty"`
+	ProjectID    string  `json:"projectId,omitempty"`
+	Domain       string  `json:"domain,omitempty"`
+	Target       string  `json:"target,omitempty"`
+	Redirect     *string `json:"redirect,omitempty"`
+	CreatedAt    int64   `json:"createdAt,omitempty"`
+	UpdatedAt    int64   `json:"updatedAt,omitempty"`
 }
 
 // ListAliasesResponse represents the response from listing aliases.
@@ -232,21 +837,42 @@ type CreateAliasRequest struct {
 	Redirect   string `json:"redirect,omitempty"`
 }
 
+// AssignAliasResponse represents the response from assigning an alias to a
+// deployment.
+type AssignAliasResponse struct {
+	UID             string `json:"uid"`
+	Alias           string `json:"alias"`
+	Created         string `json:"created,omitempty"`
+	OldDeploymentID string `json:"oldDeploymentId,omitempty"`
+}
+
 // Secret represents a Vercel secret.
 type Secret struct {
-	ID         string   `json:"id"`
-	Name       string   `json:"name"`
-	Value      string   `json:"value,omitempty"` // Only returned when creating
-	TeamID     string   `json:"teamId,omitempty"`
-	UserID     string   `json:"userId,omitempty"`
-	ProjectIDs []string `json:"projectIds,omitempty"`
-	CreatedAt  int64    `json:"createdAt,omitempty"`
-	UpdatedAt  int64    `json:"updatedAt,omitempty"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Value       string   `json:"value,omitempty"` // Only returned when creating or decrypting
+	Decryptable bool     `json:"decryptable,omitempty"`
+	TeamID      string   `json:"teamId,omitempty"`
+	UserID      string   `json:"userId,omitempty"`
+	ProjectIDs  []string `json:"projectIds,omitempty"`
+	CreatedAt   int64    `json:"createdAt,omitempty"`
+	UpdatedAt   int64    `json:"updatedAt,omitempty"`
 }
 
 // ListSecretsResponse represents the response from listing secrets.
 type ListSecretsResponse struct {
-	Secrets []Secret `json:"secrets"`
+	Secrets    []Secret `json:"secrets"`
+	Pagination struct {
+		Count int   `json:"count"`
+		Next  int64 `json:"next,omitempty"`
+		Prev  int64 `json:"prev,omitempty"`
+	} `json:"pagination"`
+}
+
+// ListSecretsOptions represents options for listing secrets.
+type ListSecretsOptions struct {
+	Limit int
+	Until int64 // the previous response's Pagination.Next
 }
 
 // CreateSecretRequest represents a request to create a secret.
@@ -256,3 +882,185 @@ type CreateSecretRequest struct {
 	TeamID     string   `json:"teamId,omitempty"`
 	ProjectIDs []string `json:"projectIds,omitempty"`
 }
+
+// WebhookEventType is the type of an event delivered to a webhook.
+type WebhookEventType string
+
+const (
+	WebhookEventDeploymentCreated   WebhookEventType = "deployment.created"
+	WebhookEventDeploymentSucceeded WebhookEventType = "deployment.succeeded"
+	WebhookEventDeploymentReady     WebhookEventType = "deployment.ready"
+	WebhookEventDeploymentCanceled  WebhookEventType = "deployment.canceled"
+	WebhookEventDeploymentError     WebhookEventType = "deployment.error"
+	WebhookEventProjectCreated      WebhookEventType = "project.created"
+	WebhookEventProjectRemoved      WebhookEventType = "project.removed"
+	WebhookEventDomainCreated       WebhookEventType = "domain.created"
+)
+
+// Webhook represents a Vercel webhook.
+type Webhook struct {
+	ID         string             `json:"id"`
+	URL        string             `json:"url"`
+	Events     []WebhookEventType `json:"events"`
+	ProjectIDs []string           `json:"projectIds,omitempty"`
+	OwnerID    string             `json:"ownerId,omitempty"`
+	Secret     string             `json:"secret,omitempty"` // Only returned when creating
+	CreatedAt  int64              `json:"createdAt,omitempty"`
+	UpdatedAt  int64              `json:"updatedAt,omitempty"`
+}
+
+// CreateWebhookRequest represents a request to create a webhook.
+type CreateWebhookRequest struct {
+	URL    string             `json:"url"`
+	Events []WebhookEventType `json:"events"`
+	// ProjectIDs limits the webhook to events of these projects. When empty
+	// the webhook receives events of all projects.
+	ProjectIDs []string `json:"projectIds,omitempty"`
+}
+
+// LogDrainSource is a source of logs delivered to a log drain.
+type LogDrainSource string
+
+const (
+	LogDrainSourceStatic   LogDrainSource = "static"
+	LogDrainSourceLambda   LogDrainSource = "lambda"
+	LogDrainSourceEdge     LogDrainSource = "edge"
+	LogDrainSourceBuild    LogDrainSource = "build"
+	LogDrainSourceExternal LogDrainSource = "external"
+)
+
+// LogDrainDeliveryFormat is the format logs are delivered to a log drain in.
+type LogDrainDeliveryFormat string
+
+const (
+	LogDrainFormatJSON   LogDrainDeliveryFormat = "json"
+	LogDrainFormatNDJSON LogDrainDeliveryFormat = "ndjson"
+	LogDrainFormatSyslog LogDrainDeliveryFormat = "syslog"
+)
+
+// LogDrain represents a Vercel log drain.
+type LogDrain struct {
+	ID             string                 `json:"id"`
+	Name           string                 `json:"name"`
+	URL            string                 `json:"url"`
+	DeliveryFormat LogDrainDeliveryFormat `json:"deliveryFormat,omitempty"`
+	Sources        []LogDrainSource       `json:"sources,omitempty"`
+	ProjectIDs     []string               `json:"projectIds,omitempty"`
+	Environments   []string               `json:"environments,omitempty"`
+	Headers        map[string]string      `json:"headers,omitempty"`
+	OwnerID        string                 `json:"ownerId,omitempty"`
+	TeamID         string                 `json:"teamId,omitempty"`
+	CreatedAt      int64                  `json:"createdAt,omitempty"`
+}
+
+// CreateLogDrainRequest represents a request to create a log drain.
+type CreateLogDrainRequest struct {
+	Name           string                 `json:"name"`
+	URL            string                 `json:"url"`
+	DeliveryFormat LogDrainDeliveryFormat `json:"deliveryFormat,omitempty"`
+	Sources        []LogDrainSource       `json:"sources,omitempty"`
+	// ProjectIDs limits the drain to logs of these projects. When empty the
+	// drain receives logs of all projects.
+	ProjectIDs []string `json:"projectIds,omitempty"`
+	// Secret signs every delivery so the receiver can verify it.
+	Secret       string            `json:"secret,omitempty"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	Environments []string          `json:"environments,omitempty"`
+}
+
+// LogDrainProxy describes the request that produced a log entry.
+type LogDrainProxy struct {
+	Timestamp   int64    `json:"timestamp"`
+	Method      string   `json:"method"`
+	Scheme      string   `json:"scheme,omitempty"`
+	Host        string   `json:"host"`
+	Path        string   `json:"path"`
+	UserAgent   []string `json:"userAgent,omitempty"`
+	Referer     string   `json:"referer,omitempty"`
+	StatusCode  int      `json:"statusCode,omitempty"`
+	ClientIP    string   `json:"clientIp,omitempty"`
+	Region      string   `json:"region,omitempty"`
+	CacheID     string   `json:"cacheId,omitempty"`
+	VercelCache string   `json:"vercelCache,omitempty"`
+}
+
+// LogDrainEntry is a log entry delivered to a log drain.
+type LogDrainEntry struct {
+	ID              string         `json:"id"`
+	Message         string         `json:"message,omitempty"`
+	Timestamp       int64          `json:"timestamp"`
+	Type            string         `json:"type,omitempty"`
+	Source          LogDrainSource `json:"source"`
+	Level           string         `json:"level,omitempty"`
+	ProjectID       string         `json:"projectId"`
+	DeploymentID    string         `json:"deploymentId"`
+	BuildID         string         `json:"buildId,omitempty"`
+	Host            string         `json:"host,omitempty"`
+	Path            string         `json:"path,omitempty"`
+	Entrypoint      string         `json:"entrypoint,omitempty"`
+	RequestID       string         `json:"requestId,omitempty"`
+	StatusCode      int            `json:"statusCode,omitempty"`
+	Destination     string         `json:"destination,omitempty"`
+	ExecutionRegion string         `json:"executionRegion,omitempty"`
+	Environment     string         `json:"environment,omitempty"`
+	Branch          string         `json:"branch,omitempty"`
+	Proxy           *LogDrainProxy `json:"proxy,omitempty"`
+}
+
+// EdgeConfig represents a Vercel Edge Config store.
+type EdgeConfig struct {
+	ID          string `json:"id"`
+	Slug        string `json:"slug"`
+	OwnerID     string `json:"ownerId,omitempty"`
+	Digest      string `json:"digest,omitempty"`
+	ItemCount   int    `json:"itemCount,omitempty"`
+	SizeInBytes int    `json:"sizeInBytes,omitempty"`
+	CreatedAt   int64  `json:"createdAt,omitempty"`
+	UpdatedAt   int64  `json:"updatedAt,omitempty"`
+}
+
+// CreateEdgeConfigRequest represents a request to create an Edge Config
+// store, optionally with initial items.
+type CreateEdgeConfigRequest struct {
+	Slug  string                 `json:"slug"`
+	Items map[string]interface{} `json:"items,omitempty"`
+}
+
+// EdgeConfigItem represents an item of an Edge Config store. Value holds the
+// raw JSON value; use DecodeValue or GetEdgeConfigItem to decode it.
+type EdgeConfigItem struct {
+	Key          string          `json:"key"`
+	Value        json.RawMessage `json:"value"`
+	Description  string          `json:"description,omitempty"`
+	EdgeConfigID string          `json:"edgeConfigId,omitempty"`
+	CreatedAt    int64           `json:"createdAt,omitempty"`
+	UpdatedAt    int64           `json:"updatedAt,omitempty"`
+}
+
+// EdgeConfigItemOperation is the operation of an Edge Config item patch.
+type EdgeConfigItemOperation string
+
+const (
+	EdgeConfigItemCreate EdgeConfigItemOperation = "create"
+	EdgeConfigItemUpdate EdgeConfigItemOperation = "update"
+	EdgeConfigItemUpsert EdgeConfigItemOperation = "upsert"
+	EdgeConfigItemDelete EdgeConfigItemOperation = "delete"
+)
+
+// EdgeConfigItemPatch is one operation of a batched Edge Config item update.
+// Value is marshaled to JSON and is ignored for deletes.
+type EdgeConfigItemPatch struct {
+	Operation   EdgeConfigItemOperation `json:"operation"`
+	Key         string                  `json:"key"`
+	Value       interface{}             `json:"value,omitempty"`
+	Description string                  `json:"description,omitempty"`
+}
+
+// EdgeConfigToken represents a read token of an Edge Config store.
+type EdgeConfigToken struct {
+	ID           string `json:"id"`
+	Token        string `json:"token"`
+	Label        string `json:"label,omitempty"`
+	EdgeConfigID string `json:"edgeConfigId,omitempty"`
+	CreatedAt    int64  `json:"createdAt,omitempty"`
+}
@@ -0,0 +1,16 @@
+package vercel
+
+import "context"
+
+// GetCurrentUser retrieves the user the client's token belongs to.
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	var resp struct {
+		User User `json:"user"`
+	}
+
+	if err := c.doRequest(ctx, "GET", "/v2/user", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.User, nil
+}
@@ -0,0 +1,243 @@
+// Package webhook receives Vercel webhook deliveries. Handler verifies the
+// signature of each delivery, decodes it into a typed event and dispatches it
+// to the callbacks registered for its type.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/OPTIC7409/vercel-wrapper/vercel"
+)
+
+// SignatureHeader is the header carrying the hex encoded HMAC-SHA1 of the
+// request body, keyed with the webhook secret.
+const SignatureHeader = "x-vercel-signature"
+
+// MaxBodySize is the largest request body Handler accepts.
+const MaxBodySize = 1 << 20
+
+// Event is a webhook delivery with its payload left undecoded.
+type Event struct {
+	ID        string                  `json:"id"`
+	Type      vercel.WebhookEventType `json:"type"`
+	CreatedAt int64                   `json:"createdAt"`
+	Region    string                  `json:"region,omitempty"`
+	Payload   json.RawMessage         `json:"payload"`
+}
+
+// DecodePayload decodes the event payload into v.
+func (e Event) DecodePayload(v interface{}) error {
+	if len(e.Payload) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(e.Payload, v)
+}
+
+// Owner identifies the team or user an event belongs to.
+type Owner struct {
+	ID string `json:"id"`
+}
+
+// DeploymentLinks holds dashboard links for a deployment event.
+type DeploymentLinks struct {
+	Deployment string `json:"deployment,omitempty"`
+	Project    string `json:"project,omitempty"`
+}
+
+// DeploymentPayload is the payload of deployment.* events. Only the fields
+// Vercel includes in the payload are set on Deployment and Project.
+type DeploymentPayload struct {
+	Team       *Owner            `json:"team,omitempty"`
+	User       *Owner            `json:"user,omitempty"`
+	Deployment vercel.Deployment `json:"deployment"`
+	Project    vercel.Project    `json:"project"`
+	Links      DeploymentLinks   `json:"links"`
+	Target     string            `json:"target,omitempty"`
+	Plan       string            `json:"plan,omitempty"`
+	Regions    []string          `json:"regions,omitempty"`
+}
+
+// DeploymentEvent is a decoded deployment.* event.
+type DeploymentEvent struct {
+	Event
+	DeploymentPayload
+}
+
+// ProjectPayload is the payload of project.* events.
+type ProjectPayload struct {
+	Team    *Owner         `json:"team,omitempty"`
+	User    *Owner         `json:"user,omitempty"`
+	Project vercel.Project `json:"project"`
+}
+
+// ProjectEvent is a decoded project.* event.
+type ProjectEvent struct {
+	Event
+	ProjectPayload
+}
+
+// Sign returns the signature of body for secret, as sent in SignatureHeader.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature is the valid signature of body
+// for secret. It always fails for an empty secret or signature.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	if secret == "" || signature == "" {
+		return false
+	}
+
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	want, _ := hex.DecodeString(Sign(secret, body))
+
+	return hmac.Equal(got, want)
+}
+
+// payloadError reports a payload that could not be decoded into the type a
+// callback expects.
+type payloadError struct {
+	err error
+}
+
+func (e *payloadError) Error() string {
+	return fmt.Sprintf("webhook: failed to decode payload: %v", e.err)
+}
+
+func (e *payloadError) Unwrap() error {
+	return e.err
+}
+
+// Handler is an http.Handler for webhook deliveries. Register callbacks
+// before serving requests; events without a callback are acknowledged and
+// dropped.
+//
+// Handler responds with 403 for a missing or invalid signature, 400 for a
+// malformed body, 500 when a callback returns an error and 200 otherwise.
+// All callbacks for an event run before the response is written, but Vercel
+// redelivers the event after any non-2xx response, so callbacks that
+// succeeded run again. Callbacks should therefore be idempotent, for example
+// by remembering Event.ID.
+type Handler struct {
+	secret    string
+	callbacks map[vercel.WebhookEventType][]func(context.Context, *Event) error
+}
+
+// NewHandler returns a Handler verifying deliveries with the webhook secret.
+func NewHandler(secret string) *Handler {
+	return &Handler{
+		secret:    secret,
+		callbacks: make(map[vercel.WebhookEventType][]func(context.Context, *Event) error),
+	}
+}
+
+// On registers fn for events of the given type, with the payload undecoded.
+func (h *Handler) On(eventType vercel.WebhookEventType, fn func(context.Context, *Event) error) {
+	h.callbacks[eventType] = append(h.callbacks[eventType], fn)
+}
+
+// OnDeployment registers fn for deployment events of the given type.
+func (h *Handler) OnDeployment(eventType vercel.WebhookEventType, fn func(context.Context, *DeploymentEvent) error) {
+	h.On(eventType, func(ctx context.Context, e *Event) error {
+		var p DeploymentPayload
+		if err := e.DecodePayload(&p); err != nil {
+			return &payloadError{err: err}
+		}
+
+		return fn(ctx, &DeploymentEvent{Event: *e, DeploymentPayload: p})
+	})
+}
+
+// OnProject registers fn for project events of the given type.
+func (h *Handler) OnProject(eventType vercel.WebhookEventType, fn func(context.Context, *ProjectEvent) error) {
+	h.On(eventType, func(ctx context.Context, e *Event) error {
+		var p ProjectPayload
+		if err := e.DecodePayload(&p); err != nil {
+			return &payloadError{err: err}
+		}
+
+		return fn(ctx, &ProjectEvent{Event: *e, ProjectPayload: p})
+	})
+}
+
+// OnDeploymentSucceeded registers fn for deployment.succeeded events.
+func (h *Handler) OnDeploymentSucceeded(fn func(context.Context, *DeploymentEvent) error) {
+	h.OnDeployment(vercel.WebhookEventDeploymentSucceeded, fn)
+}
+
+// OnDeploymentError registers fn for deployment.error events.
+func (h *Handler) OnDeploymentError(fn func(context.Context, *DeploymentEvent) error) {
+	h.OnDeployment(vercel.WebhookEventDeploymentError, fn)
+}
+
+// OnProjectCreated registers fn for project.created events.
+func (h *Handler) OnProjectCreated(fn func(context.Context, *ProjectEvent) error) {
+	h.OnProject(vercel.WebhookEventProjectCreated, fn)
+}
+
+// ServeHTTP verifies, decodes and dispatches a webhook delivery.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxBodySize+1))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	if len(body) > MaxBodySize {
+		http.Error(w, "body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if !VerifySignature(h.secret, body, r.Header.Get(SignatureHeader)) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, "invalid event", http.StatusBadRequest)
+		return
+	}
+
+	// Run every callback even after one fails, so a single failure does not
+	// leave the others unrun until the redelivery.
+	var failed, invalid bool
+	for _, fn := range h.callbacks[event.Type] {
+		if err := fn(r.Context(), &event); err != nil {
+			var pe *payloadError
+			if errors.As(err, &pe) {
+				invalid = true
+			} else {
+				failed = true
+			}
+		}
+	}
+
+	switch {
+	case failed:
+		http.Error(w, "failed to handle event", http.StatusInternalServerError)
+	case invalid:
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+	default:
+		w.WriteHeader(http.StatusOK)
+	}
+}
@@ -0,0 +1,132 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/OPTIC7409/vercel-wrapper/vercel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "webhook-secret"
+
+func deliver(h http.Handler, body, signature string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	req.Header.Set(SignatureHeader, signature)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"id":"evt-1"}`)
+	sig := Sign(testSecret, body)
+
+	assert.True(t, VerifySignature(testSecret, body, sig))
+	assert.False(t, VerifySignature(testSecret, []byte(`{"id":"evt-2"}`), sig))
+	assert.False(t, VerifySignature("other-secret", body, sig))
+	assert.False(t, VerifySignature(testSecret, body, "not-hex"))
+	assert.False(t, VerifySignature("", body, Sign("", body)))
+}
+
+func TestHandler_DispatchesTypedEvents(t *testing.T) {
+	h := NewHandler(testSecret)
+
+	var deployment *DeploymentEvent
+	h.OnDeploymentSucceeded(func(ctx context.Context, e *DeploymentEvent) error {
+		deployment = e
+		return nil
+	})
+	var project *ProjectEvent
+	h.OnProjectCreated(func(ctx context.Context, e *ProjectEvent) error {
+		project = e
+		return nil
+	})
+
+	body := `{"id":"evt-1","type":"deployment.succeeded","createdAt":1700000000000,"payload":{
+		"team":{"id":"team-1"},
+		"deployment":{"id":"dpl-1","name":"web","url":"web-abc.vercel.app"},
+		"project":{"id":"prj-1"},
+		"links":{"deployment":"https://vercel.com/acme/web/dpl-1"},
+		"target":"production"
+	}}`
+	rec := deliver(h, body, Sign(testSecret, []byte(body)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, deployment)
+	assert.Equal(t, "evt-1", deployment.ID)
+	assert.Equal(t, vercel.WebhookEventDeploymentSucceeded, deployment.Type)
+	assert.Equal(t, "dpl-1", deployment.Deployment.ID)
+	assert.Equal(t, "web-abc.vercel.app", deployment.Deployment.URL)
+	assert.Equal(t, "prj-1", deployment.Project.ID)
+	assert.Equal(t, "team-1", deployment.Team.ID)
+	assert.Equal(t, "production", deployment.Target)
+
+	body = `{"id":"evt-2","type":"project.created","payload":{"project":{"id":"prj-2","name":"api"}}}`
+	rec = deliver(h, body, Sign(testSecret, []byte(body)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, project)
+	assert.Equal(t, "api", project.Project.Name)
+}
+
+func TestHandler_Rejects(t *testing.T) {
+	called := false
+	h := NewHandler(testSecret)
+	h.OnDeploymentError(func(ctx context.Context, e *DeploymentEvent) error {
+		called = true
+		return errors.New("boom")
+	})
+
+	body := `{"id":"evt-1","type":"deployment.error","payload":{"deployment":{"id":"dpl-1"}}}`
+
+	rec := deliver(h, body, Sign("wrong-secret", []byte(body)))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.False(t, called)
+
+	rec = deliver(h, "not json", Sign(testSecret, []byte("not json")))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	bad := `{"id":"evt-1","type":"deployment.error","payload":{"deployment":"dpl-1"}}`
+	rec = deliver(h, bad, Sign(testSecret, []byte(bad)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = deliver(h, body, Sign(testSecret, []byte(body)))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.True(t, called)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhook", nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestHandler_RunsEveryCallback(t *testing.T) {
+	var ran []string
+	h := NewHandler(testSecret)
+	h.OnDeployment(vercel.WebhookEventDeploymentSucceeded, func(ctx context.Context, e *DeploymentEvent) error {
+		ran = append(ran, "first")
+		return errors.New("boom")
+	})
+	h.OnDeployment(vercel.WebhookEventDeploymentSucceeded, func(ctx context.Context, e *DeploymentEvent) error {
+		ran = append(ran, "second")
+		return nil
+	})
+
+	body := `{"id":"evt-1","type":"deployment.succeeded","payload":{"deployment":{"id":"dpl_1"}}}`
+	rec := deliver(h, body, Sign(testSecret, []byte(body)))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, []string{"first", "second"}, ran)
+}
+
+func TestHandler_IgnoresUnregisteredEvents(t *testing.T) {
+	h := NewHandler(testSecret)
+
+	body := `{"id":"evt-1","type":"domain.created","payload":{}}`
+	rec := deliver(h, body, Sign(testSecret, []byte(body)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,67 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// ListWebhooks lists the webhooks of the authenticated user or team. If
+// projectID is not empty, only webhooks for that project are returned.
+func (c *Client) ListWebhooks(ctx context.Context, projectID string) ([]Webhook, error) {
+	var webhooks []Webhook
+	if err := c.doRequest(ctx, "GET", "/v1/webhooks", map[string]string{"projectId": projectID}, nil, &webhooks); err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// GetWebhook retrieves a webhook by ID.
+func (c *Client) GetWebhook(ctx context.Context, webhookID string) (*Webhook, error) {
+	var webhook Webhook
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/v1/webhooks/%s", webhookID), nil, nil, &webhook); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// CreateWebhook creates a webhook. The returned webhook carries the secret
+// used to sign deliveries; it is not returned again afterwards.
+func (c *Client) CreateWebhook(ctx context.Context, req CreateWebhookRequest) (*Webhook, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var webhook Webhook
+	if err := c.doRequest(ctx, "POST", "/v1/webhooks", nil, req, &webhook); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// DeleteWebhook deletes a webhook by ID.
+func (c *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
+	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/v1/webhooks/%s", webhookID), nil, nil, nil)
+}
+
+// Validate checks that the request has an absolute https URL and at least
+// one event.
+func (r CreateWebhookRequest) Validate() error {
+	u, err := url.Parse(r.URL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return &ValidationError{Field: "url", Message: "must be an absolute https URL"}
+	}
+	if len(r.Events) == 0 {
+		return &ValidationError{Field: "events", Message: "at least one event is required"}
+	}
+	for _, e := range r.Events {
+		if e == "" {
+			return &ValidationError{Field: "events", Message: "event type must not be empty"}
+		}
+	}
+
+	return nil
+}
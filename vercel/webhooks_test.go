@@ -0,0 +1,87 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWebhook_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/webhooks", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body CreateWebhookRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "https://example.com/hooks/vercel", body.URL)
+		assert.Equal(t, []WebhookEventType{WebhookEventDeploymentSucceeded, WebhookEventProjectCreated}, body.Events)
+		assert.Equal(t, []string{"prj-1"}, body.ProjectIDs)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id":"hook-1","url":"https://example.com/hooks/vercel","events":["deployment.succeeded","project.created"],"projectIds":["prj-1"],"secret":"s3cret"}`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	webhook, err := c.CreateWebhook(context.Background(), CreateWebhookRequest{
+		URL:        "https://example.com/hooks/vercel",
+		Events:     []WebhookEventType{WebhookEventDeploymentSucceeded, WebhookEventProjectCreated},
+		ProjectIDs: []string{"prj-1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "hook-1", webhook.ID)
+	assert.Equal(t, "s3cret", webhook.Secret)
+}
+
+func TestCreateWebhook_Validation(t *testing.T) {
+	c := New("test-token")
+
+	_, err := c.CreateWebhook(context.Background(), CreateWebhookRequest{
+		URL:    "http://example.com/hooks/vercel",
+		Events: []WebhookEventType{WebhookEventDeploymentSucceeded},
+	})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "url", validationErr.Field)
+
+	_, err = c.CreateWebhook(context.Background(), CreateWebhookRequest{URL: "https://example.com/hooks/vercel"})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "events", validationErr.Field)
+}
+
+func TestListWebhooks_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/webhooks", r.URL.Path)
+		assert.Equal(t, "prj-1", r.URL.Query().Get("projectId"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"id":"hook-1","url":"https://example.com/hooks/vercel","events":["deployment.error"]}]`))
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	webhooks, err := c.ListWebhooks(context.Background(), "prj-1")
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, []WebhookEventType{WebhookEventDeploymentError}, webhooks[0].Events)
+}
+
+func TestDeleteWebhook_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/webhooks/hook-1", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	require.NoError(t, c.DeleteWebhook(context.Background(), "hook-1"))
+}
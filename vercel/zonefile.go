@@ -0,0 +1,644 @@
+package vercel
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ZoneReconcilePlan lists the record-level changes that make a live zone
+// match a desired set of records.
+type ZoneReconcilePlan struct {
+	Domain string
+	Add    []CreateDNSRecordRequest
+	Delete []DNSRecord
+}
+
+// String renders the plan in zone file syntax, one change per line.
+func (p *ZoneReconcilePlan) String() string {
+	if len(p.Add) == 0 && len(p.Delete) == 0 {
+		return fmt.Sprintf("%s: no changes\n", p.Domain)
+	}
+
+	var b strings.Builder
+	for _, r := range p.Delete {
+		fmt.Fprintf(&b, "- %s\n", formatZoneRecord(p.Domain, recordFromDNSRecord(r)))
+	}
+	for _, r := range p.Add {
+		fmt.Fprintf(&b, "+ %s\n", formatZoneRecord(p.Domain, r))
+	}
+
+	return b.String()
+}
+
+// ParseZoneFile parses a BIND zone file for domain into DNS records.
+//
+// $ORIGIN and $TTL directives, parenthesized multi-line records, comments
+// and owner inheritance are supported. SOA records and NS records at the
+// apex are skipped because Vercel manages them. Record types that Vercel DNS
+// does not support, and names outside the domain, are reported as errors.
+func ParseZoneFile(r io.Reader, domain string) ([]CreateDNSRecordRequest, error) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	origin := domain + "."
+	defaultTTL := 0
+	owner := ""
+
+	entries, err := readZoneEntries(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []CreateDNSRecordRequest
+	for _, e := range entries {
+		fields := e.fields
+		errorf := func(format string, args ...interface{}) error {
+			return fmt.Errorf("zone file: line %d: %s", e.line, fmt.Sprintf(format, args...))
+		}
+
+		switch strings.ToUpper(fields[0].text) {
+		case "$ORIGIN":
+			if len(fields) < 2 {
+				return nil, errorf("$ORIGIN requires a name")
+			}
+			origin = qualifyZoneName(fields[1].text, origin)
+			continue
+		case "$TTL":
+			if len(fields) < 2 {
+				return nil, errorf("$TTL requires a value")
+			}
+			ttl, err := parseZoneTTL(fields[1].text)
+			if err != nil {
+				return nil, errorf("%v", err)
+			}
+			defaultTTL = ttl
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, errorf("%s is not supported", fields[0].text)
+		}
+
+		if !e.inherit {
+			owner = qualifyZoneName(fields[0].text, origin)
+			fields = fields[1:]
+		} else if owner == "" {
+			return nil, errorf("record without an owner name")
+		}
+
+		ttl := defaultTTL
+		for len(fields) > 0 {
+			word := strings.ToUpper(fields[0].text)
+			if word == "IN" {
+				fields = fields[1:]
+				continue
+			}
+			if t, err := parseZoneTTL(fields[0].text); err == nil && !fields[0].quoted {
+				ttl = t
+				fields = fields[1:]
+				continue
+			}
+			break
+		}
+		if len(fields) == 0 {
+			return nil, errorf("missing record type")
+		}
+
+		recordType := DNSRecordType(strings.ToUpper(fields[0].text))
+		rdata := fields[1:]
+
+		name, ok := relativeZoneName(owner, domain)
+		if !ok {
+			return nil, errorf("%s is outside the zone %s", strings.TrimSuffix(owner, "."), domain)
+		}
+
+		if recordType == "SOA" || (recordType == DNSRecordNS && name == "") {
+			continue
+		}
+
+		req := CreateDNSRecordRequest{Name: name, Type: recordType, TTL: ttl}
+		switch recordType {
+		case DNSRecordA, DNSRecordAAAA:
+			if len(rdata) != 1 {
+				return nil, errorf("%s requires one address", recordType)
+			}
+			req.Value = rdata[0].text
+		case DNSRecordCNAME, DNSRecordNS:
+			if len(rdata) != 1 {
+				return nil, errorf("%s requires one target", recordType)
+			}
+			req.Value = strings.TrimSuffix(qualifyZoneName(rdata[0].text, origin), ".")
+		case DNSRecordMX:
+			if len(rdata) != 2 {
+				return nil, errorf("MX requires a preference and an exchange")
+			}
+			pref, err := strconv.Atoi(rdata[0].text)
+			if err != nil {
+				return nil, errorf("invalid MX preference %q", rdata[0].text)
+			}
+			req.MXPriority = pref
+			req.Value = strings.TrimSuffix(qualifyZoneName(rdata[1].text, origin), ".")
+		case DNSRecordTXT:
+			if len(rdata) == 0 {
+				return nil, errorf("TXT requires a value")
+			}
+			var b strings.Builder
+			for _, f := range rdata {
+				b.WriteString(f.text)
+			}
+			req.Value = b.String()
+		case DNSRecordSRV:
+			if len(rdata) != 4 {
+				return nil, errorf("SRV requires priority, weight, port and target")
+			}
+			var nums [3]int
+			for i := range nums {
+				n, err := strconv.Atoi(rdata[i].text)
+				if err != nil {
+					return nil, errorf("invalid SRV field %q", rdata[i].text)
+				}
+				nums[i] = n
+			}
+			req.SRV = &SRVRecord{
+				Priority: nums[0],
+				Weight:   nums[1],
+				Port:     nums[2],
+				Target:   strings.TrimSuffix(qualifyZoneName(rdata[3].text, origin), "."),
+			}
+		case DNSRecordCAA:
+			if len(rdata) != 3 {
+				return nil, errorf("CAA requires flags, tag and value")
+			}
+			req.Value = fmt.Sprintf("%s %s %q", rdata[0].text, rdata[1].text, rdata[2].text)
+		default:
+			return nil, errorf("unsupported record type %s", recordType)
+		}
+
+		if err := req.Validate(); err != nil {
+			return nil, errorf("%v", err)
+		}
+
+		records = append(records, req)
+	}
+
+	return records, nil
+}
+
+// WriteZoneFile writes records of domain to w in BIND zone file format.
+// Record types that cannot be expressed in a zone file, such as ALIAS, are
+// written as comments.
+func WriteZoneFile(w io.Writer, domain string, records []DNSRecord) error {
+	domain = strings.TrimSuffix(domain, ".")
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "$ORIGIN %s.\n", domain)
+	for _, r := range records {
+		switch r.Type {
+		case DNSRecordA, DNSRecordAAAA, DNSRecordCNAME, DNSRecordMX, DNSRecordTXT, DNSRecordSRV, DNSRecordCAA, DNSRecordNS:
+			fmt.Fprintln(bw, formatZoneRecord(domain, recordFromDNSRecord(r)))
+		default:
+			fmt.Fprintf(bw, "; skipped %s %s %s\n", zoneOwner(r.Name), r.Type, r.Value)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// ExportZoneFile writes the live records of a Vercel-managed domain to w in
+// BIND zone file format.
+func (c *Client) ExportZoneFile(ctx context.Context, domain string, w io.Writer) error {
+	records, err := c.listAllDNSRecords(ctx, domain)
+	if err != nil {
+		return err
+	}
+
+	return WriteZoneFile(w, domain, records)
+}
+
+// PlanZoneReconcile compares desired records, typically from ParseZoneFile,
+// with the live zone. Records created by Vercel and record types this
+// package does not manage are never deleted.
+func (c *Client) PlanZoneReconcile(ctx context.Context, domain string, desired []CreateDNSRecordRequest) (*ZoneReconcilePlan, error) {
+	live, err := c.listAllDNSRecords(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &ZoneReconcilePlan{Domain: domain}
+	matched := make([]bool, len(live))
+	for _, want := range desired {
+		key := zoneRecordKey(want)
+
+		found := false
+		for i, r := range live {
+			if matched[i] {
+				continue
+			}
+			have := recordFromDNSRecord(r)
+			if zoneRecordKey(have) == key && (want.TTL == 0 || want.TTL == have.TTL) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			plan.Add = append(plan.Add, want)
+		}
+	}
+
+	for i, r := range live {
+		if matched[i] || r.Creator == "system" {
+			continue
+		}
+		switch r.Type {
+		case DNSRecordA, DNSRecordAAAA, DNSRecordCNAME, DNSRecordMX, DNSRecordTXT, DNSRecordSRV, DNSRecordCAA, DNSRecordNS:
+			plan.Delete = append(plan.Delete, r)
+		}
+	}
+
+	return plan, nil
+}
+
+// ApplyZoneReconcile applies a plan from PlanZoneReconcile. New records are
+// created before old ones are deleted, so the zone keeps answering during a
+// migration. Only records that would conflict with a new one, such as a
+// CNAME replaced at the same name, are deleted first. If a create fails, the
+// records created so far are deleted and the deleted ones recreated, and the
+// combined error is returned.
+func (c *Client) ApplyZoneReconcile(ctx context.Context, plan *ZoneReconcilePlan) error {
+	var conflicting, rest []DNSRecord
+	for _, r := range plan.Delete {
+		if zoneRecordConflicts(r, plan.Add) {
+			conflicting = append(conflicting, r)
+		} else {
+			rest = append(rest, r)
+		}
+	}
+
+	var deleted []DNSRecord
+	var created []string
+	fail := func(err error) error {
+		if rbErr := c.rollbackZoneReconcile(ctx, plan.Domain, created, deleted); rbErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to roll back: %w", rbErr))
+		}
+		return err
+	}
+
+	for _, r := range conflicting {
+		if err := c.DeleteDNSRecord(ctx, plan.Domain, r.ID); err != nil {
+			return fail(fmt.Errorf("failed to delete %s: %w", formatZoneRecord(plan.Domain, recordFromDNSRecord(r)), err))
+		}
+		deleted = append(deleted, r)
+	}
+
+	for _, r := range plan.Add {
+		resp, err := c.CreateDNSRecord(ctx, plan.Domain, r)
+		if err != nil {
+			return fail(fmt.Errorf("failed to create %s: %w", formatZoneRecord(plan.Domain, r), err))
+		}
+		created = append(created, resp.UID)
+	}
+
+	for _, r := range rest {
+		if err := c.DeleteDNSRecord(ctx, plan.Domain, r.ID); err != nil {
+			return fmt.Errorf("failed to delete %s: %w", formatZoneRecord(plan.Domain, recordFromDNSRecord(r)), err)
+		}
+	}
+
+	return nil
+}
+
+// rollbackZoneReconcile deletes the records created by a failed
+// ApplyZoneReconcile and recreates the ones it deleted. It ignores the
+// cancellation of ctx, since a canceled apply still has to be reverted.
+func (c *Client) rollbackZoneReconcile(ctx context.Context, domain string, created []string, deleted []DNSRecord) error {
+	ctx = context.WithoutCancel(ctx)
+
+	var errs []error
+	for i := len(created) - 1; i >= 0; i-- {
+		if err := c.DeleteDNSRecord(ctx, domain, created[i]); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete created record %s: %w", created[i], err))
+		}
+	}
+	for _, r := range deleted {
+		req := recordFromDNSRecord(r)
+		if _, err := c.CreateDNSRecord(ctx, domain, req); err != nil {
+			errs = append(errs, fmt.Errorf("failed to recreate %s: %w", formatZoneRecord(domain, req), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// zoneRecordConflicts reports whether a live record must be deleted before
+// the records in add can be created: a CNAME cannot share its name with any
+// other record, and a record cannot be created twice with a different TTL.
+func zoneRecordConflicts(r DNSRecord, add []CreateDNSRecordRequest) bool {
+	have := recordFromDNSRecord(r)
+	name := strings.ToLower(strings.TrimPrefix(have.Name, "@"))
+	for _, want := range add {
+		if strings.ToLower(strings.TrimPrefix(want.Name, "@")) != name {
+			continue
+		}
+		if have.Type == DNSRecordCNAME || want.Type == DNSRecordCNAME || zoneRecordKey(have) == zoneRecordKey(want) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// listAllDNSRecords pages through ListDNSRecords and returns every record.
+func (c *Client) listAllDNSRecords(ctx context.Context, domain string) ([]DNSRecord, error) {
+	var records []DNSRecord
+	var until int64
+	for {
+		resp, err := c.ListDNSRecords(ctx, domain, 100, until)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, resp.Records...)
+		if resp.Pagination.Next == 0 || len(resp.Records) == 0 {
+			return records, nil
+		}
+		until = resp.Pagination.Next
+	}
+}
+
+// recordFromDNSRecord converts a live record into request form, splitting
+// SRV values into their fields.
+func recordFromDNSRecord(r DNSRecord) CreateDNSRecordRequest {
+	req := CreateDNSRecordRequest{
+		Name:       r.Name,
+		Type:       r.Type,
+		Value:      r.Value,
+		TTL:        r.TTL,
+		MXPriority: r.MXPriority,
+		Comment:    r.Comment,
+	}
+
+	if r.Type == DNSRecordSRV {
+		parts := strings.Fields(r.Value)
+		srv := &SRVRecord{Priority: r.Priority}
+		if len(parts) == 4 {
+			// Some responses include the priority in the value.
+			srv.Priority, _ = strconv.Atoi(parts[0])
+			parts = parts[1:]
+		}
+		if len(parts) == 3 {
+			srv.Weight, _ = strconv.Atoi(parts[0])
+			srv.Port, _ = strconv.Atoi(parts[1])
+			srv.Target = parts[2]
+		}
+		req.SRV = srv
+		req.Value = ""
+	}
+
+	return req
+}
+
+// zoneRecordKey identifies a record by everything except its TTL and comment.
+func zoneRecordKey(r CreateDNSRecordRequest) string {
+	name := strings.ToLower(r.Name)
+	if name == "@" {
+		name = ""
+	}
+
+	switch r.Type {
+	case DNSRecordCNAME, DNSRecordNS:
+		return fmt.Sprintf("%s|%s|%s", r.Type, name, normalizeZoneHost(r.Value))
+	case DNSRecordMX:
+		return fmt.Sprintf("%s|%s|%d|%s", r.Type, name, r.MXPriority, normalizeZoneHost(r.Value))
+	case DNSRecordSRV:
+		if r.SRV == nil {
+			return fmt.Sprintf("%s|%s", r.Type, name)
+		}
+		return fmt.Sprintf("%s|%s|%d|%d|%d|%s", r.Type, name, r.SRV.Priority, r.SRV.Weight, r.SRV.Port, normalizeZoneHost(r.SRV.Target))
+	case DNSRecordCAA:
+		return fmt.Sprintf("%s|%s|%s", r.Type, name, strings.Join(strings.Fields(r.Value), " "))
+	default:
+		return fmt.Sprintf("%s|%s|%s", r.Type, name, r.Value)
+	}
+}
+
+func normalizeZoneHost(host string) string {
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
+
+// formatZoneRecord renders a record as a single zone file line.
+func formatZoneRecord(domain string, r CreateDNSRecordRequest) string {
+	owner := zoneOwner(r.Name)
+	ttl := ""
+	if r.TTL > 0 {
+		ttl = strconv.Itoa(r.TTL)
+	}
+
+	var rdata string
+	switch r.Type {
+	case DNSRecordCNAME, DNSRecordNS:
+		rdata = zoneHost(r.Value)
+	case DNSRecordMX:
+		rdata = fmt.Sprintf("%d %s", r.MXPriority, zoneHost(r.Value))
+	case DNSRecordSRV:
+		if r.SRV != nil {
+			rdata = fmt.Sprintf("%d %d %d %s", r.SRV.Priority, r.SRV.Weight, r.SRV.Port, zoneHost(r.SRV.Target))
+		}
+	case DNSRecordTXT:
+		rdata = quoteZoneTXT(r.Value)
+	default:
+		rdata = r.Value
+	}
+
+	fields := []string{owner}
+	if ttl != "" {
+		fields = append(fields, ttl)
+	}
+	fields = append(fields, "IN", string(r.Type), rdata)
+
+	return strings.Join(fields, "\t")
+}
+
+func zoneOwner(name string) string {
+	if name == "" || name == "@" {
+		return "@"
+	}
+
+	return name
+}
+
+// zoneHost fully qualifies a hostname for a zone file.
+func zoneHost(host string) string {
+	if strings.HasSuffix(host, ".") {
+		return host
+	}
+
+	return host + "."
+}
+
+// quoteZoneTXT quotes a TXT value, splitting it into 255-byte strings.
+func quoteZoneTXT(value string) string {
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+	var parts []string
+	for len(value) > 255 {
+		parts = append(parts, `"`+escape.Replace(value[:255])+`"`)
+		value = value[255:]
+	}
+	parts = append(parts, `"`+escape.Replace(value)+`"`)
+
+	return strings.Join(parts, " ")
+}
+
+// qualifyZoneName returns the fully qualified form of name relative to origin.
+func qualifyZoneName(name, origin string) string {
+	switch {
+	case name == "@":
+		return strings.ToLower(origin)
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name + "." + origin)
+	}
+}
+
+// relativeZoneName converts a fully qualified name to a name relative to
+// domain, reporting false when it lies outside the domain.
+func relativeZoneName(fqdn, domain string) (string, bool) {
+	name := strings.TrimSuffix(fqdn, ".")
+	if name == domain {
+		return "", true
+	}
+	if strings.HasSuffix(name, "."+domain) {
+		return strings.TrimSuffix(name, "."+domain), true
+	}
+
+	return "", false
+}
+
+// parseZoneTTL parses a TTL given in seconds or with BIND unit suffixes
+// such as 1h30m.
+func parseZoneTTL(s string) (int, error) {
+	if n, err := strconv.Atoi(s); err == nil && n >= 0 {
+		return n, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, num, digits := 0, 0, 0
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case ch >= '0' && ch <= '9':
+			num = num*10 + int(ch-'0')
+			digits++
+		case units[ch|0x20] > 0 && digits > 0:
+			total += num * units[ch|0x20]
+			num, digits = 0, 0
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+	}
+	if digits > 0 || total == 0 {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+
+	return total, nil
+}
+
+// zoneField is a token of a zone file entry.
+type zoneField struct {
+	text   string
+	quoted bool
+}
+
+// zoneEntry is a logical zone file line, with parenthesized continuations
+// joined.
+type zoneEntry struct {
+	line    int
+	inherit bool // the line started with whitespace and reuses the previous owner
+	fields  []zoneField
+}
+
+// readZoneEntries tokenizes a zone file into logical entries.
+func readZoneEntries(r io.Reader) ([]zoneEntry, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var entries []zoneEntry
+	var current *zoneEntry
+	depth := 0
+	lineNo := 0
+
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+
+		if current == nil {
+			current = &zoneEntry{line: lineNo, inherit: len(line) > 0 && (line[0] == ' ' || line[0] == '\t')}
+		}
+
+		for i := 0; i < len(line); {
+			ch := line[i]
+			switch {
+			case ch == ';':
+				i = len(line)
+			case ch == ' ' || ch == '\t':
+				i++
+			case ch == '(':
+				depth++
+				i++
+			case ch == ')':
+				if depth == 0 {
+					return nil, fmt.Errorf("zone file: line %d: unbalanced parenthesis", lineNo)
+				}
+				depth--
+				i++
+			case ch == '"':
+				var b strings.Builder
+				i++
+				closed := false
+				for i < len(line) {
+					if line[i] == '\\' && i+1 < len(line) {
+						b.WriteByte(line[i+1])
+						i += 2
+						continue
+					}
+					if line[i] == '"' {
+						closed = true
+						i++
+						break
+					}
+					b.WriteByte(line[i])
+					i++
+				}
+				if !closed {
+					return nil, fmt.Errorf("zone file: line %d: unterminated string", lineNo)
+				}
+				current.fields = append(current.fields, zoneField{text: b.String(), quoted: true})
+			default:
+				start := i
+				for i < len(line) && !strings.ContainsRune(" \t;()\"", rune(line[i])) {
+					i++
+				}
+				current.fields = append(current.fields, zoneField{text: line[start:i]})
+			}
+		}
+
+		if depth > 0 {
+			continue
+		}
+		if len(current.fields) > 0 {
+			entries = append(entries, *current)
+		}
+		current = nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("zone file: line %d: unbalanced parenthesis", current.line)
+	}
+
+	return entries, nil
+}
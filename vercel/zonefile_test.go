@@ -0,0 +1,206 @@
+package vercel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. admin.example.com. (
+		2024010101 ; serial
+		7200       ; refresh
+		3600 1209600 3600 )
+@		IN	NS	ns1.example.com.
+@		IN	A	76.76.21.21
+		IN	MX	10 mx1
+		IN	MX	20 mx2.example.net.
+www	300	IN	CNAME	cname.vercel-dns.com.
+@		IN	TXT	"v=spf1 include:_spf.google.com" " ~all" ; split string
+_sip._tcp	IN	SRV	10 5 5060 sip
+@		IN	CAA	0 issue "letsencrypt.org"
+api.example.com.	IN	AAAA	2001:db8::1
+`
+
+func TestParseZoneFile(t *testing.T) {
+	records, err := ParseZoneFile(strings.NewReader(testZoneFile), "example.com")
+	require.NoError(t, err)
+	require.Len(t, records, 8)
+
+	assert.Equal(t, CreateDNSRecordRequest{Name: "", Type: DNSRecordA, Value: "76.76.21.21", TTL: 3600}, records[0])
+	assert.Equal(t, CreateDNSRecordRequest{Name: "", Type: DNSRecordMX, Value: "mx1.example.com", MXPriority: 10, TTL: 3600}, records[1])
+	assert.Equal(t, "mx2.example.net", records[2].Value)
+	assert.Equal(t, CreateDNSRecordRequest{Name: "www", Type: DNSRecordCNAME, Value: "cname.vercel-dns.com", TTL: 300}, records[3])
+	assert.Equal(t, "v=spf1 include:_spf.google.com ~all", records[4].Value)
+	assert.Equal(t, &SRVRecord{Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.com"}, records[5].SRV)
+	assert.Equal(t, `0 issue "letsencrypt.org"`, records[6].Value)
+	assert.Equal(t, CreateDNSRecordRequest{Name: "api", Type: DNSRecordAAAA, Value: "2001:db8::1", TTL: 3600}, records[7])
+}
+
+func TestParseZoneFile_Errors(t *testing.T) {
+	tests := map[string]string{
+		"outside zone":     "other.org. IN A 1.2.3.4\n",
+		"unsupported type": "@ IN PTR host.example.com.\n",
+		"invalid value":    "@ IN A 999.1.1.1\n",
+		"unbalanced":       "@ IN TXT ( \"a\"\n",
+		"include":          "$INCLUDE other.zone\n",
+	}
+
+	for name, zone := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseZoneFile(strings.NewReader(zone), "example.com")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestWriteZoneFile_RoundTrip(t *testing.T) {
+	records := []DNSRecord{
+		{Name: "", Type: DNSRecordA, Value: "76.76.21.21", TTL: 60},
+		{Name: "www", Type: DNSRecordCNAME, Value: "cname.vercel-dns.com"},
+		{Name: "", Type: DNSRecordTXT, Value: `say "hi"`},
+		{Name: "_sip._tcp", Type: DNSRecordSRV, Priority: 10, Value: "5 5060 sip.example.com"},
+		{Name: "", Type: "ALIAS", Value: "cname.vercel-dns.com"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteZoneFile(&buf, "example.com", records))
+	assert.Contains(t, buf.String(), "www\tIN\tCNAME\tcname.vercel-dns.com.\n")
+	assert.Contains(t, buf.String(), "; skipped @ ALIAS")
+
+	parsed, err := ParseZoneFile(&buf, "example.com")
+	require.NoError(t, err)
+	require.Len(t, parsed, 4)
+	assert.Equal(t, 60, parsed[0].TTL)
+	assert.Equal(t, `say "hi"`, parsed[2].Value)
+	assert.Equal(t, &SRVRecord{Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.com"}, parsed[3].SRV)
+}
+
+func TestPlanZoneReconcile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/domains/example.com/records", r.URL.Path)
+
+		resp := ListDNSRecordsResponse{
+			Records: []DNSRecord{
+				{ID: "rec-1", Name: "", Type: DNSRecordA, Value: "76.76.21.21", TTL: 60},
+				{ID: "rec-2", Name: "www", Type: DNSRecordCNAME, Value: "old.example.net"},
+				{ID: "rec-3", Name: "", Type: DNSRecordCAA, Value: `0 issue "letsencrypt.org"`, Creator: "system"},
+				{ID: "rec-4", Name: "", Type: "ALIAS", Value: "cname.vercel-dns.com"},
+			},
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	desired := []CreateDNSRecordRequest{
+		{Name: "", Type: DNSRecordA, Value: "76.76.21.21"},
+		{Name: "www", Type: DNSRecordCNAME, Value: "cname.vercel-dns.com"},
+	}
+	plan, err := c.PlanZoneReconcile(context.Background(), "example.com", desired)
+	require.NoError(t, err)
+
+	require.Len(t, plan.Add, 1)
+	assert.Equal(t, "cname.vercel-dns.com", plan.Add[0].Value)
+	require.Len(t, plan.Delete, 1)
+	assert.Equal(t, "rec-2", plan.Delete[0].ID)
+	assert.Equal(t, "- www\tIN\tCNAME\told.example.net.\n+ www\tIN\tCNAME\tcname.vercel-dns.com.\n", plan.String())
+}
+
+func TestApplyZoneReconcile(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateDNSRecordResponse{UID: "rec-5"})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	plan := &ZoneReconcilePlan{
+		Domain: "example.com",
+		Add:    []CreateDNSRecordRequest{{Name: "www", Type: DNSRecordCNAME, Value: "cname.vercel-dns.com"}},
+		Delete: []DNSRecord{{ID: "rec-2", Name: "www", Type: DNSRecordCNAME, Value: "old.example.net"}},
+	}
+	require.NoError(t, c.ApplyZoneReconcile(context.Background(), plan))
+	assert.Equal(t, []string{
+		"DELETE /v2/domains/example.com/records/rec-2",
+		"POST /v2/domains/example.com/records",
+	}, calls)
+}
+
+func TestApplyZoneReconcile_CreatesBeforeDeleting(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		json.NewEncoder(w).Encode(CreateDNSRecordResponse{UID: "rec-new"})
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	plan := &ZoneReconcilePlan{
+		Domain: "example.com",
+		Add:    []CreateDNSRecordRequest{{Name: "", Type: DNSRecordA, Value: "76.76.21.21"}},
+		Delete: []DNSRecord{{ID: "rec-old", Name: "", Type: DNSRecordA, Value: "192.0.2.1"}},
+	}
+	require.NoError(t, c.ApplyZoneReconcile(context.Background(), plan))
+	assert.Equal(t, []string{
+		"POST /v2/domains/example.com/records",
+		"DELETE /v2/domains/example.com/records/rec-old",
+	}, calls)
+}
+
+func TestApplyZoneReconcile_RollsBackFailedCreate(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			var req CreateDNSRecordRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			calls = append(calls, "POST "+req.Name+" "+req.Value)
+			if req.Name == "api" {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"error":{"code":"quota_exceeded","message":"too many records"}}`))
+				return
+			}
+			json.NewEncoder(w).Encode(CreateDNSRecordResponse{UID: "rec-" + req.Name})
+			return
+		}
+		calls = append(calls, r.Method+" "+r.URL.Path)
+	}))
+	defer server.Close()
+
+	c := New("test-token", WithBaseURL(server.URL))
+
+	plan := &ZoneReconcilePlan{
+		Domain: "example.com",
+		Add: []CreateDNSRecordRequest{
+			{Name: "www", Type: DNSRecordCNAME, Value: "cname.vercel-dns.com"},
+			{Name: "api", Type: DNSRecordA, Value: "76.76.21.21"},
+		},
+		Delete: []DNSRecord{
+			{ID: "rec-2", Name: "www", Type: DNSRecordCNAME, Value: "old.example.net"},
+			{ID: "rec-3", Name: "legacy", Type: DNSRecordA, Value: "192.0.2.1"},
+		},
+	}
+	err := c.ApplyZoneReconcile(context.Background(), plan)
+	assert.ErrorContains(t, err, "too many records")
+	assert.Equal(t, []string{
+		"DELETE /v2/domains/example.com/records/rec-2",
+		"POST www cname.vercel-dns.com",
+		"POST api 76.76.21.21",
+		"DELETE /v2/domains/example.com/records/rec-www",
+		"POST www old.example.net",
+	}, calls)
+}